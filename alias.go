@@ -0,0 +1,62 @@
+package randomvariate
+
+import "math/rand"
+
+// aliasTable holds the precomputed tables of Walker's alias method so that
+// categories can be drawn repeatedly from the same distribution in
+// constant time.
+type aliasTable struct {
+	q []float64
+	j []int
+}
+
+// newAliasTable builds the alias table of the probability distribution p.
+func newAliasTable(p []float64) *aliasTable {
+	// Setup uniform distribution
+	K := len(p)
+	q := make([]float64, K)
+	J := make([]int, K)
+
+	var smaller []int
+	var larger []int
+	for i, prob := range p {
+		q[i] = float64(K) * prob
+		if q[i] < 1.0 {
+			smaller = append(smaller, i)
+		} else {
+			larger = append(larger, i)
+		}
+	}
+
+	var small, large int
+	for len(smaller) > 0 && len(larger) > 0 {
+		small, smaller = smaller[len(smaller)-1], smaller[:len(smaller)-1]
+		large, larger = larger[len(larger)-1], larger[:len(larger)-1]
+
+		J[small] = large
+		q[large] = q[large] - (1.0 - q[small])
+
+		if q[large] < 1.0 {
+			smaller = append(smaller, large)
+		} else {
+			larger = append(larger, large)
+		}
+	}
+	// Leftover categories are only off from 1 due to rounding errors
+	for _, i := range smaller {
+		q[i] = 1.0
+	}
+	for _, i := range larger {
+		q[i] = 1.0
+	}
+	return &aliasTable{q: q, j: J}
+}
+
+// draw samples a category index from the alias table.
+func (t *aliasTable) draw() int {
+	kk := rand.Intn(len(t.q))
+	if rand.Float64() < t.q[kk] {
+		return kk
+	}
+	return t.j[kk]
+}
@@ -1,5 +1,7 @@
 package randomvariate
 
+import "math"
+
 // Binomial draws n samples from a binomial probability distribution given
 // by the probability p.
 // Another way to imagine this is that the function counts the number of
@@ -9,3 +11,57 @@ package randomvariate
 func Binomial(n int, p float64) int {
 	return Multinomial(n, []float64{p, 1.0 - p})[0]
 }
+
+// BinomialPMF returns the probability of observing k successes out of n
+// trials with success probability p.
+func BinomialPMF(k, n int, p float64) float64 {
+	if k < 0 || k > n {
+		return 0
+	} else if p == 0 || p == 1 {
+		if (p == 0 && k == 0) || (p == 1 && k == n) {
+			return 1
+		}
+		return 0
+	}
+	logN, _ := math.Lgamma(float64(n) + 1)
+	logK, _ := math.Lgamma(float64(k) + 1)
+	logNK, _ := math.Lgamma(float64(n-k) + 1)
+	return math.Exp(logN - logK - logNK +
+		float64(k)*math.Log(p) + float64(n-k)*math.Log1p(-p))
+}
+
+// BinomialQuantile returns the smallest number of successes k such that
+// the cumulative probability of a binomial distribution with n trials and
+// success probability prob at k is at least p. The search starts from a
+// normal approximation of the quantile and walks the cumulative
+// distribution from there.
+func BinomialQuantile(p float64, n int, prob float64) int {
+	if p <= 0 || prob == 0 {
+		return 0
+	} else if p >= 1 || prob == 1 {
+		return n
+	}
+	mean := float64(n) * prob
+	sd := math.Sqrt(mean * (1 - prob))
+	k := int(math.Max(0, math.Min(float64(n), math.Floor(mean+sd*NormalQuantile(p, 0, 1)))))
+	cdf := 1.0
+	if k < n {
+		cdf = RegularizedIncompleteBeta(1-prob, float64(n-k), float64(k)+1)
+	}
+	pmf := BinomialPMF(k, n, prob)
+	odds := prob / (1 - prob)
+	if cdf >= p {
+		for k > 0 && cdf-pmf >= p {
+			cdf -= pmf
+			pmf *= float64(k) / (float64(n-k+1) * odds)
+			k--
+		}
+		return k
+	}
+	for cdf < p && k < n {
+		pmf *= float64(n-k) / float64(k+1) * odds
+		k++
+		cdf += pmf
+	}
+	return k
+}
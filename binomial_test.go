@@ -77,3 +77,53 @@ func TestBinomial(t *testing.T) {
 		})
 	}
 }
+
+func TestBinomialPMF(t *testing.T) {
+	cases := []struct {
+		name     string
+		k, n     int
+		p        float64
+		expected float64
+	}{
+		{name: "k=0,n=1,p=0.3", k: 0, n: 1, p: 0.3, expected: 0.7},
+		{name: "k=2,n=4,p=0.5", k: 2, n: 4, p: 0.5, expected: 6.0 / 16},
+		{name: "k=3,n=5,p=0.2", k: 3, n: 5, p: 0.2, expected: 10 * 0.008 * 0.64},
+		{name: "k=0,n=3,p=0", k: 0, n: 3, p: 0, expected: 1},
+		{name: "k=3,n=3,p=1", k: 3, n: 3, p: 1, expected: 1},
+		{name: "k=4,n=3,p=0.5", k: 4, n: 3, p: 0.5, expected: 0},
+	}
+	epsilon := 1e-12
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := BinomialPMF(tc.k, tc.n, tc.p)
+			if v > tc.expected+epsilon || v < tc.expected-epsilon {
+				t.Errorf("expected value is %e, instead got %e", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestBinomialQuantile(t *testing.T) {
+	params := []struct {
+		n int
+		p float64
+	}{{1, 0.5}, {10, 0.1}, {20, 0.5}, {50, 0.97}, {1000, 0.3}, {5, 0}, {5, 1}}
+	ps := []float64{0, 1e-6, 0.01, 0.25, 0.5, 0.75, 0.99, 1}
+	for _, param := range params {
+		for _, p := range ps {
+			k := BinomialQuantile(p, param.n, param.p)
+			// The quantile is the smallest k whose CDF reaches p
+			var cdf float64
+			for i := 0; i < k; i++ {
+				cdf += BinomialPMF(i, param.n, param.p)
+			}
+			if k > 0 && cdf >= p {
+				t.Errorf("n=%d,prob=%v,p=%v: CDF at k-1=%d (%e) already reaches p", param.n, param.p, p, k-1, cdf)
+			}
+			cdf += BinomialPMF(k, param.n, param.p)
+			if cdf < p-1e-12 {
+				t.Errorf("n=%d,prob=%v,p=%v: CDF at k=%d (%e) is less than p", param.n, param.p, p, k, cdf)
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package randomvariate
+
+import "math"
+
+// GaussianRandomWalk fills dst with a random walk that starts at x0 and
+// takes steps drawn from a normal distribution with mean mu and standard
+// deviation sigma, and returns dst. dst[0] is x0 and dst[i] is the
+// position after i steps.
+func GaussianRandomWalk(dst []float64, x0, mu, sigma float64) []float64 {
+	x := x0
+	for i := range dst {
+		if i > 0 {
+			x += Normal(mu, sigma)
+		}
+		dst[i] = x
+	}
+	return dst
+}
+
+// BrownianMotion fills dst with a path of Brownian motion with drift mu and
+// volatility sigma observed at the given times, and returns dst. The path
+// starts at x0 at times[0], and times must be non-decreasing. If dst is too
+// short to hold one value per time, a new slice is allocated.
+// The increment over an interval of length dt is drawn exactly from a
+// normal distribution with mean mu*dt and variance sigma^2*dt, so the grid
+// may be arbitrarily coarse or uneven.
+func BrownianMotion(dst, times []float64, x0, mu, sigma float64) []float64 {
+	dst = pathBuffer(dst, len(times))
+	x := x0
+	for i := range times {
+		if i > 0 {
+			dt := times[i] - times[i-1]
+			x += Normal(mu*dt, sigma*math.Sqrt(dt))
+		}
+		dst[i] = x
+	}
+	return dst
+}
+
+// BrownianBridge fills dst with a path of Brownian motion with volatility
+// sigma observed at the given times, conditioned to start at a at
+// times[0] and to end at b at the last time, and returns dst. times must
+// be non-decreasing. If dst is too short to hold one value per time, a new
+// slice is allocated.
+// Each point is drawn exactly from its normal distribution conditional on
+// the previous point and the end point: given x at time s, the value at
+// time t has mean x + (t-s)/(T-s)*(b-x) and variance
+// sigma^2*(t-s)*(T-t)/(T-s), where T is the end time.
+func BrownianBridge(dst, times []float64, a, b, sigma float64) []float64 {
+	dst = pathBuffer(dst, len(times))
+	if len(times) == 0 {
+		return dst
+	}
+	end := times[len(times)-1]
+	x := a
+	for i := range times {
+		if i > 0 {
+			s, t := times[i-1], times[i]
+			if end > s {
+				mean := x + (t-s)/(end-s)*(b-x)
+				sd := sigma * math.Sqrt((t-s)*(end-t)/(end-s))
+				x = Normal(mean, sd)
+			}
+		}
+		dst[i] = x
+	}
+	dst[len(dst)-1] = b
+	return dst
+}
+
+// GeometricBrownianMotion fills dst with a path of geometric Brownian
+// motion with drift mu and volatility sigma observed at the given times,
+// and returns dst. The path starts at s0 at times[0], and times must be
+// non-decreasing. If dst is too short to hold one value per time, a new
+// slice is allocated.
+// The logarithm of the path is a Brownian motion with drift
+// mu - sigma^2/2, so each step is drawn exactly regardless of the spacing
+// of the grid.
+func GeometricBrownianMotion(dst, times []float64, s0, mu, sigma float64) []float64 {
+	dst = pathBuffer(dst, len(times))
+	s := s0
+	for i := range times {
+		if i > 0 {
+			dt := times[i] - times[i-1]
+			s *= math.Exp(Normal((mu-sigma*sigma/2)*dt, sigma*math.Sqrt(dt)))
+		}
+		dst[i] = s
+	}
+	return dst
+}
+
+// pathBuffer returns dst resliced to n elements, or a new slice if dst is
+// too short.
+func pathBuffer(dst []float64, n int) []float64 {
+	if len(dst) < n {
+		return make([]float64, n)
+	}
+	return dst[:n]
+}
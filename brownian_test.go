@@ -0,0 +1,132 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// pathMoments returns the sample mean and variance at each position of
+// paths drawn by draw.
+func pathMoments(n, iterations int, draw func(dst []float64) []float64) ([]float64, []float64) {
+	sum := make([]float64, n)
+	sumSq := make([]float64, n)
+	buf := make([]float64, n)
+	for i := 0; i < iterations; i++ {
+		path := draw(buf)
+		for j, x := range path {
+			sum[j] += x
+			sumSq[j] += x * x
+		}
+	}
+	mean := make([]float64, n)
+	variance := make([]float64, n)
+	for j := range sum {
+		mean[j] = sum[j] / float64(iterations)
+		variance[j] = sumSq[j]/float64(iterations) - mean[j]*mean[j]
+	}
+	return mean, variance
+}
+
+func TestGaussianRandomWalk(t *testing.T) {
+	x0, mu, sigma := 2.0, 0.5, 1.5
+	iterations := 20000
+	errSize := 0.05
+	rand.Seed(0)
+	mean, variance := pathMoments(11, iterations, func(dst []float64) []float64 {
+		return GaussianRandomWalk(dst, x0, mu, sigma)
+	})
+	for i := range mean {
+		expMean := x0 + float64(i)*mu
+		expVariance := float64(i) * sigma * sigma
+		if err := errSize * (1 + expVariance); expMean+err < mean[i] || expMean-err > mean[i] {
+			t.Errorf("mean (%f) at step %d is greater than expected (%f) +/- (%f)", mean[i], i, expMean, err)
+		}
+		if err := errSize * (1 + expVariance); expVariance+err < variance[i] || expVariance-err > variance[i] {
+			t.Errorf("variance (%f) at step %d is greater than expected (%f) +/- (%f)", variance[i], i, expVariance, err)
+		}
+	}
+}
+
+func TestBrownianMotion(t *testing.T) {
+	times := []float64{1, 1.01, 1.5, 1.5, 3, 7}
+	x0, mu, sigma := -1.0, 0.3, 0.8
+	iterations := 20000
+	errSize := 0.05
+	rand.Seed(0)
+	mean, variance := pathMoments(len(times), iterations, func(dst []float64) []float64 {
+		return BrownianMotion(dst, times, x0, mu, sigma)
+	})
+	for i, time := range times {
+		dt := time - times[0]
+		expMean := x0 + mu*dt
+		expVariance := sigma * sigma * dt
+		if err := errSize * (1 + expVariance); expMean+err < mean[i] || expMean-err > mean[i] {
+			t.Errorf("mean (%f) at time %v is greater than expected (%f) +/- (%f)", mean[i], time, expMean, err)
+		}
+		if err := errSize * (1 + expVariance); expVariance+err < variance[i] || expVariance-err > variance[i] {
+			t.Errorf("variance (%f) at time %v is greater than expected (%f) +/- (%f)", variance[i], time, expVariance, err)
+		}
+	}
+	if path := BrownianMotion(nil, times, x0, mu, sigma); len(path) != len(times) || path[0] != x0 {
+		t.Errorf("expected a new path of length %d starting at %f, instead got %v", len(times), x0, path)
+	}
+}
+
+func TestBrownianBridge(t *testing.T) {
+	times := []float64{0, 0.1, 0.25, 0.5, 0.9, 2}
+	a, b, sigma := 1.0, -3.0, 2.0
+	iterations := 20000
+	errSize := 0.05
+	rand.Seed(0)
+	end := times[len(times)-1]
+	mean, variance := pathMoments(len(times), iterations, func(dst []float64) []float64 {
+		path := BrownianBridge(dst, times, a, b, sigma)
+		if path[0] != a || path[len(path)-1] != b {
+			t.Fatalf("expected path to be pinned at %f and %f, instead got %v", a, b, path)
+		}
+		return path
+	})
+	for i, time := range times {
+		expMean := a + time/end*(b-a)
+		expVariance := sigma * sigma * time * (end - time) / end
+		if err := errSize * (1 + expVariance); expMean+err < mean[i] || expMean-err > mean[i] {
+			t.Errorf("mean (%f) at time %v is greater than expected (%f) +/- (%f)", mean[i], time, expMean, err)
+		}
+		if err := errSize * (1 + expVariance); expVariance+err < variance[i] || expVariance-err > variance[i] {
+			t.Errorf("variance (%f) at time %v is greater than expected (%f) +/- (%f)", variance[i], time, expVariance, err)
+		}
+	}
+}
+
+func TestGeometricBrownianMotion(t *testing.T) {
+	times := []float64{0, 0.5, 1, 4}
+	s0, mu, sigma := 10.0, 0.1, 0.4
+	iterations := 20000
+	errSize := 0.05
+	rand.Seed(0)
+	mean, variance := pathMoments(len(times), iterations, func(dst []float64) []float64 {
+		path := GeometricBrownianMotion(dst, times, s0, mu, sigma)
+		if path[0] != s0 {
+			t.Fatalf("expected path to start at %f, instead got %f", s0, path[0])
+		}
+		// Compare the moments of the logarithm of the path
+		for i, s := range path {
+			if s <= 0 {
+				t.Fatalf("expected a positive path, instead got %f", s)
+			}
+			path[i] = math.Log(s)
+		}
+		return path
+	})
+	for i, time := range times {
+		expMean := math.Log(s0) + (mu-sigma*sigma/2)*time
+		expVariance := sigma * sigma * time
+		if err := errSize * (1 + expVariance); expMean+err < mean[i] || expMean-err > mean[i] {
+			t.Errorf("mean log (%f) at time %v is greater than expected (%f) +/- (%f)", mean[i], time, expMean, err)
+		}
+		if err := errSize * (1 + expVariance); expVariance+err < variance[i] || expVariance-err > variance[i] {
+			t.Errorf("variance of log (%f) at time %v is greater than expected (%f) +/- (%f)", variance[i], time, expVariance, err)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Categorical draws a single category index from the probability
+// distribution p. Unlike Multinomial(1, p), this does not allocate and
+// returns the index of the sampled category directly. The probabilities
+// are scanned once, so the cost is at most O(K) for K categories.
+func Categorical(p []float64) int {
+	x := rand.Float64()
+	last := -1
+	for i, prob := range p {
+		if prob <= 0 {
+			continue
+		}
+		if x < prob {
+			return i
+		}
+		x -= prob
+		last = i
+	}
+	// Only reached if p sums to slightly less than 1 due to rounding
+	return last
+}
+
+// CategoricalN draws n category indices from the probability distribution
+// p. The cumulative distribution of p is computed once and each draw is
+// found by binary search, so the cost is O(K + n log K) for K categories.
+func CategoricalN(n int, p []float64) []int {
+	cumP := make([]float64, len(p))
+	cumP[0] = p[0]
+	for i := 1; i < len(p); i++ {
+		cumP[i] = cumP[i-1] + p[i]
+	}
+	total := cumP[len(cumP)-1]
+
+	result := make([]int, n)
+	for i := range result {
+		x := rand.Float64() * total
+		result[i] = sort.Search(len(cumP), func(j int) bool { return cumP[j] > x })
+	}
+	return result
+}
+
+// CategoricalAlias draws category indices repeatedly from a fixed
+// probability distribution using the alias method. Building the tables
+// costs O(K) for K categories, after which every draw takes constant time.
+type CategoricalAlias struct {
+	table *aliasTable
+}
+
+// NewCategoricalAlias builds the alias tables of the probability
+// distribution p.
+func NewCategoricalAlias(p []float64) *CategoricalAlias {
+	return &CategoricalAlias{table: newAliasTable(p)}
+}
+
+// Draw draws a single category index.
+func (c *CategoricalAlias) Draw() int {
+	return c.table.draw()
+}
+
+// DrawN draws n category indices.
+func (c *CategoricalAlias) DrawN(n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = c.table.draw()
+	}
+	return result
+}
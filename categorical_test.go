@@ -0,0 +1,97 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var categoricalCases = []struct {
+	name string
+	p    []float64
+}{
+	{name: "plen=1,dist=uniform",
+		p: []float64{1.0},
+	},
+	{name: "plen=2,dist=uniform",
+		p: []float64{0.5, 0.5},
+	},
+	{name: "plen=2,dist=zero_left",
+		p: []float64{0.0, 1.0},
+	},
+	{name: "plen=2,dist=zero_right",
+		p: []float64{1.0, 0.0},
+	},
+	{name: "plen=3,dist=skew_left",
+		p: []float64{0.6, 0.3, 0.1},
+	},
+	{name: "plen=3,dist=zero_middle",
+		p: []float64{0.5, 0.0, 0.5},
+	},
+	{name: "plen=10,dist=uniform",
+		p: []float64{0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1},
+	},
+}
+
+func checkCategoricalFrequency(t *testing.T, p []float64, draws []int) {
+	cnt := make([]int, len(p))
+	for _, k := range draws {
+		if k < 0 || k >= len(p) {
+			t.Fatalf("index (%d) is outside [0, %d)", k, len(p))
+		}
+		if p[k] == 0 {
+			t.Fatalf("index (%d) has zero probability", k)
+		}
+		cnt[k]++
+	}
+	errSize := 0.05
+	for i, v := range cnt {
+		freq := float64(v) / float64(len(draws))
+		expected := p[i]
+		if expected+errSize < freq || expected-errSize > freq {
+			t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+		}
+	}
+}
+
+func TestCategorical(t *testing.T) {
+	iterations := 10000
+	rand.Seed(0)
+	for _, tc := range categoricalCases {
+		t.Run(tc.name, func(t *testing.T) {
+			draws := make([]int, iterations)
+			for i := range draws {
+				draws[i] = Categorical(tc.p)
+			}
+			checkCategoricalFrequency(t, tc.p, draws)
+		})
+	}
+}
+
+func TestCategoricalN(t *testing.T) {
+	iterations := 10000
+	rand.Seed(0)
+	for _, tc := range categoricalCases {
+		t.Run(tc.name, func(t *testing.T) {
+			draws := CategoricalN(iterations, tc.p)
+			if len(draws) != iterations {
+				t.Fatalf("expected %d draws, instead got %d", iterations, len(draws))
+			}
+			checkCategoricalFrequency(t, tc.p, draws)
+		})
+	}
+}
+
+func TestCategoricalAlias(t *testing.T) {
+	iterations := 10000
+	rand.Seed(0)
+	for _, tc := range categoricalCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewCategoricalAlias(tc.p)
+			draws := c.DrawN(iterations / 2)
+			for i := 0; i < iterations/2; i++ {
+				draws = append(draws, c.Draw())
+			}
+			checkCategoricalFrequency(t, tc.p, draws)
+		})
+	}
+}
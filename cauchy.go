@@ -0,0 +1,27 @@
+package randomvariate
+
+import "math"
+
+// Cauchy draws a sample from a Cauchy distribution with location x0 and
+// scale gamma by inversion.
+func Cauchy(x0, gamma float64) float64 {
+	return CauchyQuantile(openUniform(), x0, gamma)
+}
+
+// CauchyPDF returns the probability density of a Cauchy distribution at x.
+func CauchyPDF(x, x0, gamma float64) float64 {
+	z := (x - x0) / gamma
+	return 1 / (math.Pi * gamma * (1 + z*z))
+}
+
+// CauchyCDF returns the cumulative probability of a Cauchy distribution at
+// x.
+func CauchyCDF(x, x0, gamma float64) float64 {
+	return 0.5 + math.Atan((x-x0)/gamma)/math.Pi
+}
+
+// CauchyQuantile returns the value x at which the cumulative probability of
+// a Cauchy distribution is p.
+func CauchyQuantile(p, x0, gamma float64) float64 {
+	return x0 + gamma*math.Tan(math.Pi*(p-0.5))
+}
@@ -0,0 +1,26 @@
+package randomvariate
+
+import "testing"
+
+func TestCauchy(t *testing.T) {
+	cases := []struct {
+		name      string
+		x0, gamma float64
+	}{
+		{name: "x0=0,gamma=1", x0: 0.0, gamma: 1.0},
+		{name: "x0=3,gamma=0.5", x0: 3.0, gamma: 0.5},
+		{name: "x0=-2,gamma=4", x0: -2.0, gamma: 4.0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Cauchy(tc.x0, tc.gamma) }
+			cdf := func(x float64) float64 { return CauchyCDF(x, tc.x0, tc.gamma) }
+			pdf := func(x float64) float64 { return CauchyPDF(x, tc.x0, tc.gamma) }
+			quantile := func(p float64) float64 { return CauchyQuantile(p, tc.x0, tc.gamma) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package randomvariate
+
+import "math"
+
+// ChiSquare draws a sample from a chi-square distribution with k degrees of
+// freedom, which is a gamma distribution with shape k/2 and scale 2.
+func ChiSquare(k float64) float64 {
+	return Gamma(k/2, 2)
+}
+
+// ChiSquarePDF returns the probability density of a chi-square
+// distribution with k degrees of freedom at x.
+func ChiSquarePDF(x, k float64) float64 {
+	return GammaPDF(x, k/2, 2)
+}
+
+// ChiSquareCDF returns the cumulative probability of a chi-square
+// distribution with k degrees of freedom at x.
+func ChiSquareCDF(x, k float64) float64 {
+	return GammaCDF(x, k/2, 2)
+}
+
+// NoncentralChiSquare draws a sample from a noncentral chi-square
+// distribution with k degrees of freedom and noncentrality parameter
+// lambda. Uses the representation as a chi-square distribution with
+// k + 2J degrees of freedom where J is Poisson distributed with mean
+// lambda/2.
+func NoncentralChiSquare(k, lambda float64) float64 {
+	j := poissonCount(lambda / 2)
+	return ChiSquare(k + 2*float64(j))
+}
+
+// NoncentralChiSquarePDF returns the probability density of a noncentral
+// chi-square distribution with k degrees of freedom and noncentrality
+// parameter lambda at x.
+func NoncentralChiSquarePDF(x, k, lambda float64) float64 {
+	return poissonWeightedSum(lambda/2, func(j int) float64 {
+		return ChiSquarePDF(x, k+2*float64(j))
+	})
+}
+
+// NoncentralChiSquareCDF returns the cumulative probability of a
+// noncentral chi-square distribution with k degrees of freedom and
+// noncentrality parameter lambda at x.
+func NoncentralChiSquareCDF(x, k, lambda float64) float64 {
+	return poissonWeightedSum(lambda/2, func(j int) float64 {
+		return ChiSquareCDF(x, k+2*float64(j))
+	})
+}
+
+// poissonWeightedSum computes the sum of f(j) weighted by the Poisson
+// probability of j for a Poisson distribution with mean mu. Terms are
+// summed outward from the mode of the Poisson distribution until the
+// remaining weights are negligible.
+func poissonWeightedSum(mu float64, f func(j int) float64) float64 {
+	mode := int(math.Floor(mu))
+	var sum float64
+	for j := mode; j >= 0; j-- {
+		w := PoissonPMF(j, mu)
+		sum += w * f(j)
+		if w < 1e-17 && j < mode {
+			break
+		}
+	}
+	for j := mode + 1; ; j++ {
+		w := PoissonPMF(j, mu)
+		sum += w * f(j)
+		if w < 1e-17 {
+			break
+		}
+	}
+	return sum
+}
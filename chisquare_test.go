@@ -0,0 +1,59 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChiSquare(t *testing.T) {
+	cases := []struct {
+		name   string
+		k      float64
+		points []float64
+	}{
+		{name: "k=1", k: 1.0, points: []float64{0.1, 0.5, 1, 3.841458820694124}},
+		{name: "k=4", k: 4.0, points: []float64{1, 3, 5, 9}},
+		{name: "k=30", k: 30.0, points: []float64{20, 28, 35, 45}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return ChiSquare(tc.k) }
+			cdf := func(x float64) float64 { return ChiSquareCDF(x, tc.k) }
+			pdf := func(x float64) float64 { return ChiSquarePDF(x, tc.k) }
+			checkEmpiricalCDF(t, draw, cdf, tc.points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.points[0], tc.points[len(tc.points)-1])
+		})
+	}
+	// Upper 5% critical value with one degree of freedom
+	if v := ChiSquareCDF(3.841458820694124, 1); math.Abs(v-0.95) > 1e-12 {
+		t.Errorf("expected value is %e, instead got %e", 0.95, v)
+	}
+}
+
+func TestNoncentralChiSquare(t *testing.T) {
+	cases := []struct {
+		name      string
+		k, lambda float64
+		points    []float64
+	}{
+		{name: "k=2,lambda=0", k: 2.0, lambda: 0.0, points: []float64{0.5, 1, 2, 5}},
+		{name: "k=3,lambda=2", k: 3.0, lambda: 2.0, points: []float64{1, 3, 5, 10}},
+		{name: "k=5,lambda=50", k: 5.0, lambda: 50.0, points: []float64{40, 50, 60, 70}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return NoncentralChiSquare(tc.k, tc.lambda) }
+			cdf := func(x float64) float64 { return NoncentralChiSquareCDF(x, tc.k, tc.lambda) }
+			pdf := func(x float64) float64 { return NoncentralChiSquarePDF(x, tc.k, tc.lambda) }
+			checkEmpiricalCDF(t, draw, cdf, tc.points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.points[0], tc.points[len(tc.points)-1])
+		})
+	}
+	// Zero noncentrality reduces to the central distribution
+	for _, x := range []float64{0.5, 2, 7} {
+		expected := ChiSquareCDF(x, 3)
+		if v := NoncentralChiSquareCDF(x, 3, 0); math.Abs(v-expected) > 1e-12 {
+			t.Errorf("expected value is %e, instead got %e", expected, v)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// ConwayMaxwellPoisson draws a sample from a Conway-Maxwell-Poisson
+// distribution with rate lambda and dispersion nu > 0, where the probability
+// of observing k events is proportional to lambda^k / (k!)^nu. The
+// distribution is over-dispersed relative to the Poisson when nu < 1 and
+// under-dispersed when nu > 1, and reduces to the Poisson when nu = 1.
+// Uses the fast rejection sampler of Benson and Friel (2021) that does not
+// require the normalizing constant. The envelope is a Poisson distribution
+// when nu >= 1 and a geometric distribution otherwise. When lambda = 0,
+// all of the probability mass is at 0.
+func ConwayMaxwellPoisson(lambda, nu float64) int {
+	if lambda == 0 {
+		return 0
+	}
+	mu := math.Pow(lambda, 1/nu)
+	logMu := math.Log(mu)
+	// logF is the log of the unnormalized target (mu^k / k!)^nu
+	logF := func(k float64) float64 {
+		logKFactorial, _ := math.Lgamma(k + 1)
+		return nu * (k*logMu - logKFactorial)
+	}
+
+	if nu >= 1 {
+		// Poisson envelope with mean mu
+		m := math.Floor(mu)
+		logBound := (nu - 1) / nu * logF(m)
+		for {
+			y := float64(poissonCount(mu))
+			logAlpha := (nu-1)/nu*logF(y) - logBound
+			if math.Log(rand.Float64()) <= logAlpha {
+				return int(y)
+			}
+		}
+	}
+
+	// Geometric envelope with success probability p
+	p := 2 * nu / (2*mu*nu + 1 + nu)
+	log1mp := math.Log1p(-p)
+	m := math.Floor(mu / math.Pow(1-p, 1/nu))
+	logBound := logF(m) - m*log1mp
+	for {
+		y := math.Floor(math.Log(rand.Float64()) / log1mp)
+		logAlpha := logF(y) - y*log1mp - logBound
+		if math.Log(rand.Float64()) <= logAlpha {
+			return int(y)
+		}
+	}
+}
+
+// ConwayMaxwellPoissonLogZ returns the logarithm of the normalizing
+// constant Z(lambda, nu), the sum of lambda^k / (k!)^nu over all k >= 0.
+func ConwayMaxwellPoissonLogZ(lambda, nu float64) float64 {
+	logZ, _, _ := cmpSeries(lambda, nu)
+	return logZ
+}
+
+// ConwayMaxwellPoissonLogPMF returns the log-probability of observing k
+// events from a Conway-Maxwell-Poisson distribution.
+func ConwayMaxwellPoissonLogPMF(k int, lambda, nu float64) float64 {
+	if k < 0 {
+		return math.Inf(-1)
+	} else if lambda == 0 {
+		if k == 0 {
+			return 0
+		}
+		return math.Inf(-1)
+	}
+	logKFactorial, _ := math.Lgamma(float64(k) + 1)
+	return float64(k)*math.Log(lambda) - nu*logKFactorial - ConwayMaxwellPoissonLogZ(lambda, nu)
+}
+
+// ConwayMaxwellPoissonMean returns the mean of a Conway-Maxwell-Poisson
+// distribution.
+func ConwayMaxwellPoissonMean(lambda, nu float64) float64 {
+	_, mean, _ := cmpSeries(lambda, nu)
+	return mean
+}
+
+// ConwayMaxwellPoissonVariance returns the variance of a
+// Conway-Maxwell-Poisson distribution.
+func ConwayMaxwellPoissonVariance(lambda, nu float64) float64 {
+	_, _, variance := cmpSeries(lambda, nu)
+	return variance
+}
+
+// cmpSeries sums the Conway-Maxwell-Poisson series to compute the log of
+// the normalizing constant, the mean and the variance. Terms are scaled by
+// the term at the mode to avoid overflow, and summation stops once the
+// remaining terms are negligible.
+func cmpSeries(lambda, nu float64) (float64, float64, float64) {
+	if lambda == 0 {
+		// Point mass at 0
+		return 0, 0, 0
+	}
+	logLambda := math.Log(lambda)
+	logTerm := func(k float64) float64 {
+		logKFactorial, _ := math.Lgamma(k + 1)
+		return k*logLambda - nu*logKFactorial
+	}
+
+	mode := math.Floor(math.Pow(lambda, 1/nu))
+	logMax := logTerm(mode)
+	var s0, s1, s2 float64
+	for k := 0.0; ; k++ {
+		logW := logTerm(k) - logMax
+		if k > mode && logW < -40 {
+			break
+		}
+		w := math.Exp(logW)
+		s0 += w
+		s1 += k * w
+		s2 += k * k * w
+	}
+	mean := s1 / s0
+	return logMax + math.Log(s0), mean, s2/s0 - mean*mean
+}
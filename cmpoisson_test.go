@@ -0,0 +1,129 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/montanaflynn/stats"
+)
+
+func TestConwayMaxwellPoisson(t *testing.T) {
+	cases := []struct {
+		name   string
+		lambda float64
+		nu     float64
+	}{
+		{name: "lambda=3,nu=1",
+			lambda: 3.0,
+			nu:     1.0,
+		},
+		{name: "lambda=10,nu=2",
+			lambda: 10.0,
+			nu:     2.0,
+		},
+		{name: "lambda=2,nu=0.5",
+			lambda: 2.0,
+			nu:     0.5,
+		},
+		{name: "lambda=0.5,nu=0.1",
+			lambda: 0.5,
+			nu:     0.1,
+		},
+		{name: "lambda=100,nu=3",
+			lambda: 100.0,
+			nu:     3.0,
+		},
+	}
+	iterations := 100000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var cnt []float64
+			for i := 0; i < iterations; i++ {
+				cnt = append(cnt, float64(ConwayMaxwellPoisson(tc.lambda, tc.nu)))
+			}
+			// Check mean and variance
+			mean, _ := stats.Mean(cnt)
+			variance, _ := stats.Variance(cnt)
+			expected := ConwayMaxwellPoissonMean(tc.lambda, tc.nu)
+			err := expected * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+			expected = ConwayMaxwellPoissonVariance(tc.lambda, tc.nu)
+			err = expected * errSize
+			if expected+err <= variance || expected-err >= variance {
+				t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, expected, err)
+			}
+		})
+	}
+}
+
+func TestConwayMaxwellPoissonLogPMF(t *testing.T) {
+	cases := []struct {
+		name   string
+		lambda float64
+		nu     float64
+	}{
+		{name: "lambda=3,nu=1",
+			lambda: 3.0,
+			nu:     1.0,
+		},
+		{name: "lambda=10,nu=2",
+			lambda: 10.0,
+			nu:     2.0,
+		},
+		{name: "lambda=0.5,nu=0.1",
+			lambda: 0.5,
+			nu:     0.1,
+		},
+	}
+	epsilon := 1e-10
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var total float64
+			for k := 0; k < 1000; k++ {
+				total += math.Exp(ConwayMaxwellPoissonLogPMF(k, tc.lambda, tc.nu))
+			}
+			if math.Abs(total-1) > epsilon {
+				t.Errorf("PMF sums to %f instead of 1", total)
+			}
+		})
+	}
+
+	// nu = 1 reduces to the Poisson distribution
+	for k := 0; k < 20; k++ {
+		v := math.Exp(ConwayMaxwellPoissonLogPMF(k, 4.0, 1.0))
+		expected := PoissonPMF(k, 4.0)
+		if math.Abs(v-expected) > epsilon {
+			t.Errorf("expected value is %e, instead got %e", expected, v)
+		}
+	}
+	// nu = 0 and lambda < 1 reduces to a geometric series
+	logZ := ConwayMaxwellPoissonLogZ(0.5, 0.0)
+	if expected := math.Log(2); math.Abs(logZ-expected) > epsilon {
+		t.Errorf("expected value is %e, instead got %e", expected, logZ)
+	}
+}
+
+func TestConwayMaxwellPoissonZeroRate(t *testing.T) {
+	for _, nu := range []float64{0.5, 1, 2} {
+		for i := 0; i < 100; i++ {
+			if k := ConwayMaxwellPoisson(0, nu); k != 0 {
+				t.Fatalf("nu=%v: expected 0, instead got %d", nu, k)
+			}
+		}
+		if v := ConwayMaxwellPoissonLogPMF(0, 0, nu); v != 0 {
+			t.Errorf("nu=%v: expected log-probability of 0 at k=0, instead got %f", nu, v)
+		}
+		if v := ConwayMaxwellPoissonLogPMF(1, 0, nu); !math.IsInf(v, -1) {
+			t.Errorf("nu=%v: expected log-probability of -Inf at k=1, instead got %f", nu, v)
+		}
+		if m, v := ConwayMaxwellPoissonMean(0, nu), ConwayMaxwellPoissonVariance(0, nu); m != 0 || v != 0 {
+			t.Errorf("nu=%v: expected mean and variance of 0, instead got %f and %f", nu, m, v)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package randomvariate
+
+// CompoundPoisson draws a sample from a compound Poisson distribution. The
+// number of events is drawn from a Poisson distribution with mean lambda,
+// and the sample is the sum of independent jump sizes drawn from jump for
+// each event. Returns 0 if no events occur.
+func CompoundPoisson(lambda float64, jump Distribution) float64 {
+	n := poissonCount(lambda)
+	var sum float64
+	for i := 0; i < n; i++ {
+		sum += jump.Rand()
+	}
+	return sum
+}
+
+// PoissonMixture draws a count from a Poisson distribution whose mean is
+// itself random and drawn from rate. For example, a gamma-distributed rate
+// yields a negative binomial count. Negative rates are treated as 0.
+func PoissonMixture(rate Distribution) int {
+	return poissonCount(rate.Rand())
+}
@@ -0,0 +1,108 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/montanaflynn/stats"
+)
+
+func TestCompoundPoisson(t *testing.T) {
+	cases := []struct {
+		name     string
+		lambda   float64
+		jump     Distribution
+		mean     float64
+		variance float64
+	}{
+		{name: "lambda=2,jump=constant",
+			lambda:   2.0,
+			jump:     DistributionFunc(func() float64 { return 3.0 }),
+			mean:     6.0,
+			variance: 18.0,
+		},
+		{name: "lambda=5,jump=uniform",
+			lambda:   5.0,
+			jump:     DistributionFunc(func() float64 { return Uniform(0, 2) }),
+			mean:     5.0,
+			variance: 5.0 * 4.0 / 3.0,
+		},
+		{name: "lambda=50,jump=exponential",
+			lambda:   50.0,
+			jump:     DistributionFunc(func() float64 { return Exponential(2) }),
+			mean:     25.0,
+			variance: 25.0,
+		},
+	}
+	iterations := 100000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var cnt []float64
+			for i := 0; i < iterations; i++ {
+				cnt = append(cnt, CompoundPoisson(tc.lambda, tc.jump))
+			}
+			// Check mean and variance
+			mean, _ := stats.Mean(cnt)
+			variance, _ := stats.Variance(cnt)
+			err := tc.mean * errSize
+			if tc.mean+err <= mean || tc.mean-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, tc.mean, err)
+			}
+			err = tc.variance * errSize
+			if tc.variance+err <= variance || tc.variance-err >= variance {
+				t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, tc.variance, err)
+			}
+		})
+	}
+}
+
+func TestPoissonMixture(t *testing.T) {
+	cases := []struct {
+		name     string
+		rate     Distribution
+		mean     float64
+		variance float64
+	}{
+		{name: "rate=constant",
+			rate:     DistributionFunc(func() float64 { return 4.0 }),
+			mean:     4.0,
+			variance: 4.0,
+		},
+		{name: "rate=uniform",
+			rate:     DistributionFunc(func() float64 { return Uniform(0, 6) }),
+			mean:     3.0,
+			variance: 3.0 + 3.0,
+		},
+		{name: "rate=exponential",
+			rate:     DistributionFunc(func() float64 { return Exponential(0.5) }),
+			mean:     2.0,
+			variance: 2.0 + 4.0,
+		},
+	}
+	iterations := 100000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var cnt []float64
+			for i := 0; i < iterations; i++ {
+				cnt = append(cnt, float64(PoissonMixture(tc.rate)))
+			}
+			// Check mean and variance
+			mean, _ := stats.Mean(cnt)
+			variance, _ := stats.Variance(cnt)
+			err := tc.mean * errSize
+			if tc.mean+err <= mean || tc.mean-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, tc.mean, err)
+			}
+			err = tc.variance * errSize
+			if tc.variance+err <= variance || tc.variance-err >= variance {
+				t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, tc.variance, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package randomvariate
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// Copula is a multivariate distribution whose marginals are uniform on
+// [0, 1). Samples from a copula carry the dependence structure only; use
+// ApplyQuantiles to give each coordinate the desired marginal
+// distribution.
+type Copula interface {
+	// Dim returns the number of coordinates of each sample.
+	Dim() int
+	// Rand draws a sample into dst and returns it. If dst is too short
+	// to hold a sample, a new slice is allocated.
+	Rand(dst []float64) []float64
+}
+
+// GaussianCopula is the copula of a multivariate normal distribution with
+// a given correlation matrix. A GaussianCopula is not safe for concurrent
+// use.
+type GaussianCopula struct {
+	mvn *MVNormal
+}
+
+// NewGaussianCopula creates a Gaussian copula with the correlation matrix
+// corr. Returns an error if corr is not a valid correlation matrix.
+func NewGaussianCopula(corr [][]float64) (*GaussianCopula, error) {
+	mvn, err := newCorrelatedNormal(corr)
+	if err != nil {
+		return nil, err
+	}
+	return &GaussianCopula{mvn: mvn}, nil
+}
+
+// Dim returns the number of coordinates of each sample.
+func (c *GaussianCopula) Dim() int {
+	return c.mvn.Dim()
+}
+
+// Rand draws a sample into dst and returns it. If dst is too short to hold
+// a sample, a new slice is allocated.
+func (c *GaussianCopula) Rand(dst []float64) []float64 {
+	dst = c.mvn.Rand(dst)
+	for i, z := range dst {
+		dst[i] = NormalCDF(z, 0, 1)
+	}
+	return dst
+}
+
+// StudentTCopula is the copula of a multivariate Student's t distribution
+// with nu degrees of freedom and a given correlation matrix. Unlike the
+// Gaussian copula, it has tail dependence: extreme values tend to occur
+// together. A StudentTCopula is not safe for concurrent use.
+type StudentTCopula struct {
+	nu  float64
+	mvn *MVNormal
+}
+
+// NewStudentTCopula creates a Student's t copula with nu degrees of freedom
+// and the correlation matrix corr. Returns an error if nu is not positive
+// or corr is not a valid correlation matrix.
+func NewStudentTCopula(nu float64, corr [][]float64) (*StudentTCopula, error) {
+	if !(nu > 0) {
+		return nil, errors.New("degrees of freedom must be positive")
+	}
+	mvn, err := newCorrelatedNormal(corr)
+	if err != nil {
+		return nil, err
+	}
+	return &StudentTCopula{nu: nu, mvn: mvn}, nil
+}
+
+// Dim returns the number of coordinates of each sample.
+func (c *StudentTCopula) Dim() int {
+	return c.mvn.Dim()
+}
+
+// Rand draws a sample into dst and returns it. If dst is too short to hold
+// a sample, a new slice is allocated.
+func (c *StudentTCopula) Rand(dst []float64) []float64 {
+	dst = c.mvn.Rand(dst)
+	w := math.Sqrt(ChiSquare(c.nu) / c.nu)
+	for i, z := range dst {
+		dst[i] = StudentTCDF(z/w, c.nu)
+	}
+	return dst
+}
+
+// newCorrelatedNormal creates a zero-mean multivariate normal distribution
+// with the correlation matrix corr, checking that its diagonal is one.
+func newCorrelatedNormal(corr [][]float64) (*MVNormal, error) {
+	for i, row := range corr {
+		if i < len(row) && math.Abs(row[i]-1) > 1e-12 {
+			return nil, errors.New("correlation matrix must have ones on the diagonal")
+		}
+	}
+	return NewMVNormal(make([]float64, len(corr)), corr)
+}
+
+// ClaytonCopula is an n-dimensional Clayton copula with parameter
+// theta > 0. Its Kendall's tau is theta / (theta + 2) and it has lower
+// tail dependence.
+type ClaytonCopula struct {
+	dim   int
+	theta float64
+}
+
+// NewClaytonCopula creates an n-dimensional Clayton copula with parameter
+// theta. Returns an error if theta is not positive.
+func NewClaytonCopula(n int, theta float64) (*ClaytonCopula, error) {
+	if !(theta > 0) {
+		return nil, errors.New("Clayton copula parameter must be positive")
+	}
+	return &ClaytonCopula{dim: n, theta: theta}, nil
+}
+
+// Dim returns the number of coordinates of each sample.
+func (c *ClaytonCopula) Dim() int {
+	return c.dim
+}
+
+// Rand draws a sample into dst and returns it. If dst is too short to hold
+// a sample, a new slice is allocated. Uses the method of Marshall and
+// Olkin (1988) with a gamma-distributed frailty.
+func (c *ClaytonCopula) Rand(dst []float64) []float64 {
+	v := Gamma(1/c.theta, 1)
+	return archimedean(dst, c.dim, v, func(t float64) float64 {
+		return math.Pow(1+t, -1/c.theta)
+	})
+}
+
+// FrankCopula is an n-dimensional Frank copula with parameter theta > 0.
+// It has no tail dependence.
+type FrankCopula struct {
+	dim   int
+	theta float64
+}
+
+// NewFrankCopula creates an n-dimensional Frank copula with parameter
+// theta. Returns an error if theta is not positive.
+func NewFrankCopula(n int, theta float64) (*FrankCopula, error) {
+	if !(theta > 0) {
+		return nil, errors.New("Frank copula parameter must be positive")
+	}
+	return &FrankCopula{dim: n, theta: theta}, nil
+}
+
+// Dim returns the number of coordinates of each sample.
+func (c *FrankCopula) Dim() int {
+	return c.dim
+}
+
+// Rand draws a sample into dst and returns it. If dst is too short to hold
+// a sample, a new slice is allocated. Uses the method of Marshall and
+// Olkin (1988) with a frailty from the logarithmic series distribution.
+func (c *FrankCopula) Rand(dst []float64) []float64 {
+	a := math.Expm1(-c.theta)
+	v := float64(logarithmicVariate(-a))
+	return archimedean(dst, c.dim, v, func(t float64) float64 {
+		return -math.Log1p(a*math.Exp(-t)) / c.theta
+	})
+}
+
+// GumbelCopula is an n-dimensional Gumbel copula with parameter
+// theta >= 1. Its Kendall's tau is 1 - 1/theta and it has upper tail
+// dependence. theta = 1 corresponds to independence.
+type GumbelCopula struct {
+	dim   int
+	theta float64
+}
+
+// NewGumbelCopula creates an n-dimensional Gumbel copula with parameter
+// theta. Returns an error if theta is less than 1.
+func NewGumbelCopula(n int, theta float64) (*GumbelCopula, error) {
+	if !(theta >= 1) {
+		return nil, errors.New("Gumbel copula parameter must be at least 1")
+	}
+	return &GumbelCopula{dim: n, theta: theta}, nil
+}
+
+// Dim returns the number of coordinates of each sample.
+func (c *GumbelCopula) Dim() int {
+	return c.dim
+}
+
+// Rand draws a sample into dst and returns it. If dst is too short to hold
+// a sample, a new slice is allocated. Uses the method of Marshall and
+// Olkin (1988) with a positive stable frailty.
+func (c *GumbelCopula) Rand(dst []float64) []float64 {
+	v := 1.0
+	if c.theta > 1 {
+		alpha := 1 / c.theta
+		v = Stable(alpha, 1, math.Pow(math.Cos(math.Pi*alpha/2), c.theta), 0)
+	}
+	return archimedean(dst, c.dim, v, func(t float64) float64 {
+		return math.Exp(-math.Pow(t, 1/c.theta))
+	})
+}
+
+// archimedean fills dst with psi(E_i / v) for independent standard
+// exponential variates E_i, where psi is the generator of an Archimedean
+// copula and v is a draw from the frailty distribution whose Laplace
+// transform is psi.
+func archimedean(dst []float64, n int, v float64, psi func(t float64) float64) []float64 {
+	if len(dst) < n {
+		dst = make([]float64, n)
+	}
+	dst = dst[:n]
+	for i := range dst {
+		dst[i] = psi(rand.ExpFloat64() / v)
+	}
+	return dst
+}
+
+// logarithmicVariate draws a sample from the logarithmic series
+// distribution with parameter p in (0, 1), where the probability of k is
+// -p^k / (k log(1-p)) for k >= 1. Uses the LK algorithm of Kemp (1981).
+func logarithmicVariate(p float64) int {
+	v := rand.Float64()
+	if v >= p {
+		return 1
+	}
+	q := -math.Expm1(math.Log1p(-p) * rand.Float64())
+	if v <= q*q {
+		return int(1 + math.Log(v)/math.Log(q))
+	} else if v <= q {
+		return 2
+	}
+	return 1
+}
+
+// ApplyQuantiles maps the uniform sample u, such as a draw from a Copula,
+// to a sample with the given marginal distributions and stores it in dst.
+// Coordinate i is transformed by quantiles[i], or by quantiles[0] if only
+// one quantile function is given. If dst is too short to hold the result,
+// a new slice is allocated. dst may be the same slice as u.
+// Discrete marginals are obtained from their quantile functions in the
+// same way, for example
+//
+//	func(p float64) float64 { return float64(PoissonQuantile(p, 2)) }
+func ApplyQuantiles(dst, u []float64, quantiles ...func(p float64) float64) []float64 {
+	if len(dst) < len(u) {
+		dst = make([]float64, len(u))
+	}
+	dst = dst[:len(u)]
+	for i, p := range u {
+		q := quantiles[0]
+		if len(quantiles) > 1 {
+			q = quantiles[i]
+		}
+		dst[i] = q(p)
+	}
+	return dst
+}
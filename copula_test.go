@@ -0,0 +1,197 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// kendallTau returns the sample Kendall's tau between x and y.
+func kendallTau(x, y []float64) float64 {
+	var concordant float64
+	for i := range x {
+		for j := i + 1; j < len(x); j++ {
+			s := (x[i] - x[j]) * (y[i] - y[j])
+			if s > 0 {
+				concordant++
+			} else if s < 0 {
+				concordant--
+			}
+		}
+	}
+	n := float64(len(x))
+	return concordant / (n * (n - 1) / 2)
+}
+
+// frankTau returns Kendall's tau of a Frank copula with parameter theta,
+// 1 - 4/theta (1 - D(theta)), where D is the first Debye function.
+func frankTau(theta float64) float64 {
+	steps := 10000
+	h := theta / float64(steps)
+	var integral float64
+	for i := 0; i < steps; i++ {
+		t := (float64(i) + 0.5) * h
+		integral += t / math.Expm1(t) * h
+	}
+	return 1 - 4/theta*(1-integral/theta)
+}
+
+func TestCopula(t *testing.T) {
+	corr := [][]float64{{1, 0.7, 0.2}, {0.7, 1, -0.4}, {0.2, -0.4, 1}}
+	gaussian, _ := NewGaussianCopula(corr)
+	studentT, _ := NewStudentTCopula(4, corr)
+	clayton, _ := NewClaytonCopula(3, 2)
+	frank, _ := NewFrankCopula(3, 5)
+	gumbel, _ := NewGumbelCopula(3, 1.5)
+	independent, _ := NewGumbelCopula(3, 1)
+	ellipticalTau := func(i, j int) float64 { return 2 / math.Pi * math.Asin(corr[i][j]) }
+	cases := []struct {
+		name   string
+		copula Copula
+		tau    func(i, j int) float64
+	}{
+		{name: "gaussian", copula: gaussian, tau: ellipticalTau},
+		{name: "student_t", copula: studentT, tau: ellipticalTau},
+		{name: "clayton,theta=2", copula: clayton, tau: func(i, j int) float64 { return 2.0 / 4 }},
+		{name: "frank,theta=5", copula: frank, tau: func(i, j int) float64 { return frankTau(5) }},
+		{name: "gumbel,theta=1.5", copula: gumbel, tau: func(i, j int) float64 { return 1 - 1/1.5 }},
+		{name: "gumbel,theta=1", copula: independent, tau: func(i, j int) float64 { return 0 }},
+	}
+	iterations := 3000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := tc.copula.Dim()
+			samples := make([][]float64, n)
+			for j := range samples {
+				samples[j] = make([]float64, iterations)
+			}
+			buf := make([]float64, n)
+			for i := 0; i < iterations; i++ {
+				u := tc.copula.Rand(buf)
+				for j, v := range u {
+					if v < 0 || v > 1 {
+						t.Fatalf("expected value in [0, 1], instead got %f", v)
+					}
+					samples[j][i] = v
+				}
+			}
+			// Check uniform marginals
+			for j := range samples {
+				var sum, sumSq float64
+				for _, v := range samples[j] {
+					sum += v
+					sumSq += v * v
+				}
+				mean := sum / float64(iterations)
+				variance := sumSq/float64(iterations) - mean*mean
+				if 0.5+errSize < mean || 0.5-errSize > mean {
+					t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, 0.5, errSize)
+				}
+				if 1.0/12+errSize/2 < variance || 1.0/12-errSize/2 > variance {
+					t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, 1.0/12, errSize/2)
+				}
+			}
+			// Check pairwise dependence
+			for i := 0; i < n; i++ {
+				for j := i + 1; j < n; j++ {
+					tau := kendallTau(samples[i], samples[j])
+					expected := tc.tau(i, j)
+					if expected+errSize < tau || expected-errSize > tau {
+						t.Errorf("Kendall's tau (%f) of (%d, %d) is greater than expected (%f) +/- (%f)", tau, i, j, expected, errSize)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewCopulaError(t *testing.T) {
+	notCorrelation := [][]float64{{2, 0}, {0, 1}}
+	indefinite := [][]float64{{1, 0.9, 0.9}, {0.9, 1, -0.9}, {0.9, -0.9, 1}}
+	cases := []struct {
+		name string
+		fn   func() error
+	}{
+		{name: "gaussian,diagonal", fn: func() error { _, err := NewGaussianCopula(notCorrelation); return err }},
+		{name: "gaussian,indefinite", fn: func() error { _, err := NewGaussianCopula(indefinite); return err }},
+		{name: "student_t,nu=0", fn: func() error { _, err := NewStudentTCopula(0, [][]float64{{1}}); return err }},
+		{name: "clayton,theta=0", fn: func() error { _, err := NewClaytonCopula(2, 0); return err }},
+		{name: "frank,theta=-1", fn: func() error { _, err := NewFrankCopula(2, -1); return err }},
+		{name: "gumbel,theta=0.5", fn: func() error { _, err := NewGumbelCopula(2, 0.5); return err }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.fn(); err == nil {
+				t.Errorf("expected an error, instead got nil")
+			}
+		})
+	}
+}
+
+func TestLogarithmicVariate(t *testing.T) {
+	cases := []struct {
+		name string
+		p    float64
+	}{
+		{name: "p=0.1", p: 0.1},
+		{name: "p=0.5", p: 0.5},
+		{name: "p=0.99", p: 0.99},
+	}
+	iterations := 20000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sum int
+			for i := 0; i < iterations; i++ {
+				k := logarithmicVariate(tc.p)
+				if k < 1 {
+					t.Fatalf("expected a positive count, instead got %d", k)
+				}
+				sum += k
+			}
+			mean := float64(sum) / float64(iterations)
+			expected := -tc.p / ((1 - tc.p) * math.Log1p(-tc.p))
+			if err := errSize * expected; expected+err < mean || expected-err > mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+		})
+	}
+}
+
+func TestApplyQuantiles(t *testing.T) {
+	rand.Seed(0)
+	c, _ := NewGaussianCopula([][]float64{{1, 0.8}, {0.8, 1}})
+	poisson := func(p float64) float64 { return float64(PoissonQuantile(p, 3)) }
+	binomial := func(p float64) float64 { return float64(BinomialQuantile(p, 10, 0.4)) }
+	iterations := 20000
+	errSize := 0.05
+	u := make([]float64, 2)
+	var sumX, sumY, sumXY, sumXX, sumYY float64
+	for i := 0; i < iterations; i++ {
+		x := ApplyQuantiles(u, c.Rand(u), poisson, binomial)
+		if x[0] != math.Floor(x[0]) || x[1] < 0 || x[1] > 10 {
+			t.Fatalf("unexpected sample %v", x)
+		}
+		sumX += x[0]
+		sumY += x[1]
+		sumXY += x[0] * x[1]
+		sumXX += x[0] * x[0]
+		sumYY += x[1] * x[1]
+	}
+	n := float64(iterations)
+	meanX, meanY := sumX/n, sumY/n
+	if 3+errSize*3 < meanX || 3-errSize*3 > meanX {
+		t.Errorf("Poisson mean (%f) is greater than expected (%f) +/- (%f)", meanX, 3.0, errSize*3)
+	}
+	if 4+errSize*4 < meanY || 4-errSize*4 > meanY {
+		t.Errorf("binomial mean (%f) is greater than expected (%f) +/- (%f)", meanY, 4.0, errSize*4)
+	}
+	cov := sumXY/n - meanX*meanY
+	corr := cov / math.Sqrt((sumXX/n-meanX*meanX)*(sumYY/n-meanY*meanY))
+	if corr < 0.6 {
+		t.Errorf("expected marginals to be strongly correlated, instead got correlation %f", corr)
+	}
+}
@@ -0,0 +1,18 @@
+package randomvariate
+
+// Distribution is a probability distribution that can be sampled from.
+type Distribution interface {
+	Rand() float64
+}
+
+// DistributionFunc is an adapter that allows an ordinary function to be
+// used as a Distribution. For example, a Poisson distribution with a mean
+// of 2 can be written as
+//
+//	DistributionFunc(func() float64 { return float64(Poisson(2)) })
+type DistributionFunc func() float64
+
+// Rand draws a sample by calling f.
+func (f DistributionFunc) Rand() float64 {
+	return f()
+}
@@ -0,0 +1,67 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestDistributionFunc(t *testing.T) {
+	var d Distribution = DistributionFunc(func() float64 { return 42.0 })
+	if v := d.Rand(); v != 42.0 {
+		t.Errorf("expected value is %f, instead got %f", 42.0, v)
+	}
+}
+
+// checkEmpiricalCDF draws samples from draw and checks that the fraction of
+// samples at or below each point is close to cdf at that point.
+func checkEmpiricalCDF(t *testing.T, draw func() float64, cdf func(float64) float64, points []float64) {
+	iterations := 20000
+	errSize := 0.015
+	rand.Seed(0)
+	samples := make([]float64, iterations)
+	for i := range samples {
+		samples[i] = draw()
+	}
+	sort.Float64s(samples)
+	for _, x := range points {
+		freq := float64(sort.Search(len(samples), func(i int) bool { return samples[i] > x })) / float64(iterations)
+		expected := cdf(x)
+		if expected+errSize < freq || expected-errSize > freq {
+			t.Errorf("empirical CDF at %f (%f) is greater than expected (%f) +/- (%f)", x, freq, expected, errSize)
+		}
+	}
+}
+
+// checkPDFIntegratesToCDF numerically integrates pdf over [a, b] with
+// Simpson's rule and checks that it matches cdf(b) - cdf(a).
+func checkPDFIntegratesToCDF(t *testing.T, pdf, cdf func(float64) float64, a, b float64) {
+	steps := 20000
+	h := (b - a) / float64(steps)
+	sum := pdf(a) + pdf(b)
+	for i := 1; i < steps; i++ {
+		w := 2.0
+		if i%2 == 1 {
+			w = 4.0
+		}
+		sum += w * pdf(a+float64(i)*h)
+	}
+	integral := sum * h / 3
+	expected := cdf(b) - cdf(a)
+	epsilon := 1e-6
+	if integral > expected+epsilon || integral < expected-epsilon {
+		t.Errorf("integral of PDF over [%f, %f] (%f) is not equal to expected (%f)", a, b, integral, expected)
+	}
+}
+
+// checkQuantileInvertsCDF checks that cdf(quantile(p)) returns p for a
+// range of probabilities.
+func checkQuantileInvertsCDF(t *testing.T, quantile, cdf func(float64) float64) {
+	epsilon := 1e-10
+	for _, p := range []float64{0.001, 0.05, 0.3, 0.5, 0.7, 0.95, 0.999} {
+		x := quantile(p)
+		if v := cdf(x); v > p+epsilon || v < p-epsilon {
+			t.Errorf("CDF at quantile %f (%f) is not equal to expected (%f)", x, v, p)
+		}
+	}
+}
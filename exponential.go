@@ -0,0 +1,9 @@
+package randomvariate
+
+import "math/rand"
+
+// Exponential draws a sample from an exponential distribution with the given
+// rate lambda. The mean of the distribution is 1/lambda.
+func Exponential(lambda float64) float64 {
+	return rand.ExpFloat64() / lambda
+}
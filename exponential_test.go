@@ -0,0 +1,50 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/montanaflynn/stats"
+)
+
+func TestExponential(t *testing.T) {
+	cases := []struct {
+		name   string
+		lambda float64
+	}{
+		{name: "lambda=0.1",
+			lambda: 0.1,
+		},
+		{name: "lambda=1",
+			lambda: 1.0,
+		},
+		{name: "lambda=10",
+			lambda: 10.0,
+		},
+	}
+	iterations := 100000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var cnt []float64
+			for i := 0; i < iterations; i++ {
+				cnt = append(cnt, Exponential(tc.lambda))
+			}
+			// Check mean and variance
+			mean, _ := stats.Mean(cnt)
+			variance, _ := stats.Variance(cnt)
+			expected := 1 / tc.lambda
+			err := expected * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+			expected = 1 / (tc.lambda * tc.lambda)
+			err = expected * errSize * 2
+			if expected+err <= variance || expected-err >= variance {
+				t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, expected, err)
+			}
+		})
+	}
+}
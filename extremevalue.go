@@ -0,0 +1,115 @@
+package randomvariate
+
+import "math"
+
+// Gumbel draws a sample from a Gumbel distribution with location mu and
+// scale beta by inversion.
+func Gumbel(mu, beta float64) float64 {
+	return GumbelQuantile(openUniform(), mu, beta)
+}
+
+// GumbelPDF returns the probability density of a Gumbel distribution at x.
+func GumbelPDF(x, mu, beta float64) float64 {
+	z := (x - mu) / beta
+	return math.Exp(-z-math.Exp(-z)) / beta
+}
+
+// GumbelCDF returns the cumulative probability of a Gumbel distribution at
+// x.
+func GumbelCDF(x, mu, beta float64) float64 {
+	return math.Exp(-math.Exp(-(x - mu) / beta))
+}
+
+// GumbelQuantile returns the value x at which the cumulative probability of
+// a Gumbel distribution is p.
+func GumbelQuantile(p, mu, beta float64) float64 {
+	return mu - beta*math.Log(-math.Log(p))
+}
+
+// Frechet draws a sample from a Fréchet distribution with shape alpha,
+// scale s and location m by inversion.
+func Frechet(alpha, s, m float64) float64 {
+	return FrechetQuantile(openUniform(), alpha, s, m)
+}
+
+// FrechetPDF returns the probability density of a Fréchet distribution at
+// x.
+func FrechetPDF(x, alpha, s, m float64) float64 {
+	if x <= m {
+		return 0
+	}
+	z := (x - m) / s
+	return alpha / s * math.Pow(z, -1-alpha) * math.Exp(-math.Pow(z, -alpha))
+}
+
+// FrechetCDF returns the cumulative probability of a Fréchet distribution
+// at x.
+func FrechetCDF(x, alpha, s, m float64) float64 {
+	if x <= m {
+		return 0
+	}
+	return math.Exp(-math.Pow((x-m)/s, -alpha))
+}
+
+// FrechetQuantile returns the value x at which the cumulative probability
+// of a Fréchet distribution is p.
+func FrechetQuantile(p, alpha, s, m float64) float64 {
+	return m + s*math.Pow(-math.Log(p), -1/alpha)
+}
+
+// GEV draws a sample from a generalized extreme value distribution with
+// location mu, scale sigma and shape xi by inversion. The distribution is
+// a Gumbel distribution when xi = 0, a Fréchet distribution when xi > 0
+// and a reversed Weibull distribution when xi < 0.
+func GEV(mu, sigma, xi float64) float64 {
+	return GEVQuantile(openUniform(), mu, sigma, xi)
+}
+
+// GEVPDF returns the probability density of a generalized extreme value
+// distribution at x.
+func GEVPDF(x, mu, sigma, xi float64) float64 {
+	t, ok := gevT(x, mu, sigma, xi)
+	if !ok {
+		return 0
+	}
+	return math.Pow(t, xi+1) * math.Exp(-t) / sigma
+}
+
+// GEVCDF returns the cumulative probability of a generalized extreme value
+// distribution at x.
+func GEVCDF(x, mu, sigma, xi float64) float64 {
+	t, ok := gevT(x, mu, sigma, xi)
+	if !ok {
+		// Outside the support, below the lower bound when xi > 0 and
+		// above the upper bound when xi < 0
+		if xi > 0 {
+			return 0
+		}
+		return 1
+	}
+	return math.Exp(-t)
+}
+
+// GEVQuantile returns the value x at which the cumulative probability of a
+// generalized extreme value distribution is p.
+func GEVQuantile(p, mu, sigma, xi float64) float64 {
+	if xi == 0 {
+		return GumbelQuantile(p, mu, sigma)
+	}
+	return mu + sigma*math.Expm1(-xi*math.Log(-math.Log(p)))/xi
+}
+
+// gevT computes t(x) of the generalized extreme value distribution, where
+// the cumulative probability is exp(-t(x)). Returns false if x is outside
+// the support of the distribution.
+func gevT(x, mu, sigma, xi float64) (float64, bool) {
+	z := (x - mu) / sigma
+	if xi == 0 {
+		return math.Exp(-z), true
+	}
+	v := 1 + xi*z
+	if v <= 0 {
+		return 0, false
+	}
+	return math.Pow(v, -1/xi), true
+}
@@ -0,0 +1,91 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGumbel(t *testing.T) {
+	cases := []struct {
+		name     string
+		mu, beta float64
+	}{
+		{name: "mu=0,beta=1", mu: 0.0, beta: 1.0},
+		{name: "mu=5,beta=0.5", mu: 5.0, beta: 0.5},
+		{name: "mu=-2,beta=3", mu: -2.0, beta: 3.0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Gumbel(tc.mu, tc.beta) }
+			cdf := func(x float64) float64 { return GumbelCDF(x, tc.mu, tc.beta) }
+			pdf := func(x float64) float64 { return GumbelPDF(x, tc.mu, tc.beta) }
+			quantile := func(p float64) float64 { return GumbelQuantile(p, tc.mu, tc.beta) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
+
+func TestFrechet(t *testing.T) {
+	cases := []struct {
+		name        string
+		alpha, s, m float64
+	}{
+		{name: "alpha=1,s=1,m=0", alpha: 1.0, s: 1.0, m: 0.0},
+		{name: "alpha=3,s=2,m=1", alpha: 3.0, s: 2.0, m: 1.0},
+		{name: "alpha=0.7,s=0.5,m=-1", alpha: 0.7, s: 0.5, m: -1.0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Frechet(tc.alpha, tc.s, tc.m) }
+			cdf := func(x float64) float64 { return FrechetCDF(x, tc.alpha, tc.s, tc.m) }
+			pdf := func(x float64) float64 { return FrechetPDF(x, tc.alpha, tc.s, tc.m) }
+			quantile := func(p float64) float64 { return FrechetQuantile(p, tc.alpha, tc.s, tc.m) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
+
+func TestGEV(t *testing.T) {
+	cases := []struct {
+		name          string
+		mu, sigma, xi float64
+	}{
+		{name: "mu=0,sigma=1,xi=0", mu: 0.0, sigma: 1.0, xi: 0.0},
+		{name: "mu=1,sigma=2,xi=0.3", mu: 1.0, sigma: 2.0, xi: 0.3},
+		{name: "mu=-1,sigma=0.5,xi=-0.4", mu: -1.0, sigma: 0.5, xi: -0.4},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return GEV(tc.mu, tc.sigma, tc.xi) }
+			cdf := func(x float64) float64 { return GEVCDF(x, tc.mu, tc.sigma, tc.xi) }
+			pdf := func(x float64) float64 { return GEVPDF(x, tc.mu, tc.sigma, tc.xi) }
+			quantile := func(p float64) float64 { return GEVQuantile(p, tc.mu, tc.sigma, tc.xi) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+
+	// Outside the support
+	if v := GEVCDF(-10, 0, 1, 0.5); v != 0 {
+		t.Errorf("expected value is %e, instead got %e", 0.0, v)
+	}
+	if v := GEVCDF(10, 0, 1, -0.5); v != 1 {
+		t.Errorf("expected value is %e, instead got %e", 1.0, v)
+	}
+	// Positive shape is a shifted and rescaled Frechet distribution
+	xi := 0.25
+	for _, x := range []float64{-1, 0.5, 3} {
+		expected := FrechetCDF(x, 1/xi, 1/xi, -1/xi)
+		if v := GEVCDF(x, 0, 1, xi); math.Abs(v-expected) > 1e-12 {
+			t.Errorf("expected value is %e, instead got %e", expected, v)
+		}
+	}
+}
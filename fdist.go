@@ -0,0 +1,68 @@
+package randomvariate
+
+import "math"
+
+// F draws a sample from Fisher's F distribution with d1 and d2 degrees of
+// freedom, as the ratio of two independent chi-square variates each
+// divided by its degrees of freedom.
+func F(d1, d2 float64) float64 {
+	return (ChiSquare(d1) / d1) / (ChiSquare(d2) / d2)
+}
+
+// FPDF returns the probability density of Fisher's F distribution with d1
+// and d2 degrees of freedom at x.
+func FPDF(x, d1, d2 float64) float64 {
+	if x < 0 {
+		return 0
+	} else if x == 0 {
+		if d1 < 2 {
+			return math.Inf(1)
+		} else if d1 == 2 {
+			return 1
+		}
+		return 0
+	}
+	logGammaA, _ := math.Lgamma(d1 / 2)
+	logGammaB, _ := math.Lgamma(d2 / 2)
+	logGammaAB, _ := math.Lgamma((d1 + d2) / 2)
+	logBeta := logGammaA + logGammaB - logGammaAB
+	return math.Exp(d1/2*math.Log(d1/d2) + (d1/2-1)*math.Log(x) -
+		(d1+d2)/2*math.Log1p(d1*x/d2) - logBeta)
+}
+
+// FCDF returns the cumulative probability of Fisher's F distribution with
+// d1 and d2 degrees of freedom at x.
+func FCDF(x, d1, d2 float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return RegularizedIncompleteBeta(d1*x/(d1*x+d2), d1/2, d2/2)
+}
+
+// NoncentralF draws a sample from a noncentral F distribution with d1 and
+// d2 degrees of freedom and noncentrality parameter lambda, where the
+// numerator is a noncentral chi-square variate.
+func NoncentralF(d1, d2, lambda float64) float64 {
+	return (NoncentralChiSquare(d1, lambda) / d1) / (ChiSquare(d2) / d2)
+}
+
+// NoncentralFPDF returns the probability density of a noncentral F
+// distribution with d1 and d2 degrees of freedom and noncentrality
+// parameter lambda at x.
+func NoncentralFPDF(x, d1, d2, lambda float64) float64 {
+	return poissonWeightedSum(lambda/2, func(j int) float64 {
+		// Conditional on j, the variate is a rescaled central F
+		k := d1 + 2*float64(j)
+		return d1 / k * FPDF(x*d1/k, k, d2)
+	})
+}
+
+// NoncentralFCDF returns the cumulative probability of a noncentral F
+// distribution with d1 and d2 degrees of freedom and noncentrality
+// parameter lambda at x.
+func NoncentralFCDF(x, d1, d2, lambda float64) float64 {
+	return poissonWeightedSum(lambda/2, func(j int) float64 {
+		k := d1 + 2*float64(j)
+		return FCDF(x*d1/k, k, d2)
+	})
+}
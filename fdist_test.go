@@ -0,0 +1,53 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestF(t *testing.T) {
+	cases := []struct {
+		name   string
+		d1, d2 float64
+		points []float64
+	}{
+		{name: "d1=1,d2=10", d1: 1.0, d2: 10.0, points: []float64{0.1, 0.5, 1, 4.964602743730711}},
+		{name: "d1=5,d2=2", d1: 5.0, d2: 2.0, points: []float64{0.5, 1, 3, 10}},
+		{name: "d1=20,d2=30", d1: 20.0, d2: 30.0, points: []float64{0.6, 0.9, 1.2, 1.8}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return F(tc.d1, tc.d2) }
+			cdf := func(x float64) float64 { return FCDF(x, tc.d1, tc.d2) }
+			pdf := func(x float64) float64 { return FPDF(x, tc.d1, tc.d2) }
+			checkEmpiricalCDF(t, draw, cdf, tc.points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.points[0], tc.points[len(tc.points)-1])
+		})
+	}
+	// F(1, nu) is the square of a t variate with nu degrees of freedom
+	x := 2.228138851986274
+	if v, expected := FCDF(x*x, 1, 10), 2*StudentTCDF(x, 10)-1; math.Abs(v-expected) > 1e-12 {
+		t.Errorf("expected value is %e, instead got %e", expected, v)
+	}
+}
+
+func TestNoncentralF(t *testing.T) {
+	cases := []struct {
+		name           string
+		d1, d2, lambda float64
+		points         []float64
+	}{
+		{name: "d1=3,d2=10,lambda=0", d1: 3.0, d2: 10.0, lambda: 0.0, points: []float64{0.3, 1, 2, 4}},
+		{name: "d1=3,d2=10,lambda=4", d1: 3.0, d2: 10.0, lambda: 4.0, points: []float64{0.5, 1.5, 3, 6}},
+		{name: "d1=5,d2=20,lambda=30", d1: 5.0, d2: 20.0, lambda: 30.0, points: []float64{4, 6, 8, 12}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return NoncentralF(tc.d1, tc.d2, tc.lambda) }
+			cdf := func(x float64) float64 { return NoncentralFCDF(x, tc.d1, tc.d2, tc.lambda) }
+			pdf := func(x float64) float64 { return NoncentralFPDF(x, tc.d1, tc.d2, tc.lambda) }
+			checkEmpiricalCDF(t, draw, cdf, tc.points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.points[0], tc.points[len(tc.points)-1])
+		})
+	}
+}
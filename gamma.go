@@ -0,0 +1,54 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Gamma draws a sample from a gamma distribution with the given shape and
+// scale. The mean of the distribution is shape * scale.
+// Uses the method of Marsaglia and Tsang (2000). Shapes less than 1 are
+// handled by drawing with shape + 1 and multiplying by U^(1/shape).
+func Gamma(shape, scale float64) float64 {
+	if shape < 1 {
+		u := rand.Float64()
+		return Gamma(shape+1, scale) * math.Pow(u, 1/shape)
+	}
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := rand.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := rand.Float64()
+		if math.Log(u) < 0.5*x*x+d-d*v+d*math.Log(v) {
+			return d * v * scale
+		}
+	}
+}
+
+// GammaPDF returns the probability density of a gamma distribution with
+// the given shape and scale at x.
+func GammaPDF(x, shape, scale float64) float64 {
+	if x < 0 {
+		return 0
+	} else if x == 0 {
+		if shape < 1 {
+			return math.Inf(1)
+		} else if shape == 1 {
+			return 1 / scale
+		}
+		return 0
+	}
+	logGammaShape, _ := math.Lgamma(shape)
+	return math.Exp((shape-1)*math.Log(x) - x/scale - logGammaShape - shape*math.Log(scale))
+}
+
+// GammaCDF returns the cumulative probability of a gamma distribution with
+// the given shape and scale at x.
+func GammaCDF(x, shape, scale float64) float64 {
+	return RegularizedGammaP(shape, x/scale)
+}
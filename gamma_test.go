@@ -0,0 +1,49 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/montanaflynn/stats"
+)
+
+func TestGamma(t *testing.T) {
+	cases := []struct {
+		name         string
+		shape, scale float64
+	}{
+		{name: "shape=0.3,scale=1", shape: 0.3, scale: 1.0},
+		{name: "shape=1,scale=2", shape: 1.0, scale: 2.0},
+		{name: "shape=2.5,scale=0.5", shape: 2.5, scale: 0.5},
+		{name: "shape=100,scale=1", shape: 100.0, scale: 1.0},
+	}
+	iterations := 100000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var cnt []float64
+			for i := 0; i < iterations; i++ {
+				cnt = append(cnt, Gamma(tc.shape, tc.scale))
+			}
+			// Check mean and variance
+			mean, _ := stats.Mean(cnt)
+			variance, _ := stats.Variance(cnt)
+			expected := tc.shape * tc.scale
+			err := expected * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+			expected = tc.shape * tc.scale * tc.scale
+			err = expected * errSize
+			if expected+err <= variance || expected-err >= variance {
+				t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, expected, err)
+			}
+			// Check density against the CDF
+			pdf := func(x float64) float64 { return GammaPDF(x, tc.shape, tc.scale) }
+			cdf := func(x float64) float64 { return GammaCDF(x, tc.shape, tc.scale) }
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.shape*tc.scale/2, tc.shape*tc.scale*2)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package randomvariate
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// GeneralizedPoisson draws a sample from Consul's generalized Poisson
+// distribution with parameters theta > 0 and lambda, where the probability
+// of observing k events is
+//
+//	theta * (theta + k*lambda)^(k-1) * exp(-theta - k*lambda) / k!
+//
+// The distribution is defined for max(-1, -theta/4) <= lambda < 1. It is
+// over-dispersed when 0 < lambda < 1, under-dispersed when lambda < 0, and
+// reduces to the Poisson when lambda = 0. Returns an error if theta is not
+// positive or lambda is outside this range.
+// For lambda >= 0, the count is the total progeny of a branching process
+// with a Poisson(theta) number of ancestors where each individual has a
+// Poisson(lambda) number of offspring. For lambda < 0, the support is
+// truncated where theta + k*lambda <= 0 and the count is drawn by inversion
+// over the renormalized probabilities.
+func GeneralizedPoisson(theta, lambda float64) (int, error) {
+	if !(theta > 0) {
+		return 0, errors.New("generalized Poisson theta must be positive")
+	} else if !validGeneralizedPoisson(theta, lambda) {
+		return 0, errors.New("generalized Poisson lambda must be in [max(-1, -theta/4), 1)")
+	}
+	if lambda >= 0 {
+		total := poissonCount(theta)
+		generation := total
+		for generation > 0 {
+			generation = poissonCount(lambda * float64(generation))
+			total += generation
+		}
+		return total, nil
+	}
+
+	// Log-probabilities over the finite support
+	var logP []float64
+	logMax := math.Inf(-1)
+	for k := 0; theta+float64(k)*lambda > 0; k++ {
+		v := GeneralizedPoissonLogPMF(k, theta, lambda)
+		logP = append(logP, v)
+		logMax = math.Max(logMax, v)
+	}
+	p := make([]float64, len(logP))
+	var total float64
+	for k, v := range logP {
+		p[k] = math.Exp(v - logMax)
+		total += p[k]
+	}
+	u := rand.Float64() * total
+	for k, v := range p {
+		if u < v {
+			return k, nil
+		}
+		u -= v
+	}
+	return len(p) - 1, nil
+}
+
+// GeneralizedPoissonLogPMF returns the log-probability of observing k
+// events from Consul's generalized Poisson distribution. Returns negative
+// infinity outside the support. When lambda < 0, the probabilities given
+// by the formula do not sum exactly to 1 because of truncation.
+func GeneralizedPoissonLogPMF(k int, theta, lambda float64) float64 {
+	x := theta + float64(k)*lambda
+	if k < 0 || x <= 0 {
+		return math.Inf(-1)
+	}
+	kf := float64(k)
+	logKFactorial, _ := math.Lgamma(kf + 1)
+	return math.Log(theta) + (kf-1)*math.Log(x) - x - logKFactorial
+}
+
+// GeneralizedPoissonMean returns the mean of Consul's generalized Poisson
+// distribution, theta / (1 - lambda). Returns NaN for parameters outside
+// the range accepted by GeneralizedPoisson.
+func GeneralizedPoissonMean(theta, lambda float64) float64 {
+	if !validGeneralizedPoisson(theta, lambda) {
+		return math.NaN()
+	}
+	return theta / (1 - lambda)
+}
+
+// GeneralizedPoissonVariance returns the variance of Consul's generalized
+// Poisson distribution, theta / (1 - lambda)^3. Returns NaN for parameters
+// outside the range accepted by GeneralizedPoisson.
+func GeneralizedPoissonVariance(theta, lambda float64) float64 {
+	if !validGeneralizedPoisson(theta, lambda) {
+		return math.NaN()
+	}
+	return theta / math.Pow(1-lambda, 3)
+}
+
+// validGeneralizedPoisson reports whether theta > 0 and
+// max(-1, -theta/4) <= lambda < 1.
+func validGeneralizedPoisson(theta, lambda float64) bool {
+	return theta > 0 && lambda >= math.Max(-1, -theta/4) && lambda < 1
+}
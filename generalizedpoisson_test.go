@@ -0,0 +1,114 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/montanaflynn/stats"
+)
+
+func TestGeneralizedPoisson(t *testing.T) {
+	cases := []struct {
+		name   string
+		theta  float64
+		lambda float64
+	}{
+		{name: "theta=3,lambda=0",
+			theta:  3.0,
+			lambda: 0.0,
+		},
+		{name: "theta=2,lambda=0.5",
+			theta:  2.0,
+			lambda: 0.5,
+		},
+		{name: "theta=100,lambda=0.2",
+			theta:  100.0,
+			lambda: 0.2,
+		},
+		{name: "theta=10,lambda=-0.2",
+			theta:  10.0,
+			lambda: -0.2,
+		},
+	}
+	iterations := 100000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var cnt []float64
+			for i := 0; i < iterations; i++ {
+				k, err := GeneralizedPoisson(tc.theta, tc.lambda)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				cnt = append(cnt, float64(k))
+			}
+			// Check mean and variance
+			mean, _ := stats.Mean(cnt)
+			variance, _ := stats.Variance(cnt)
+			expected := GeneralizedPoissonMean(tc.theta, tc.lambda)
+			err := expected * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+			expected = GeneralizedPoissonVariance(tc.theta, tc.lambda)
+			err = expected * errSize
+			if expected+err <= variance || expected-err >= variance {
+				t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, expected, err)
+			}
+		})
+	}
+}
+
+func TestGeneralizedPoissonError(t *testing.T) {
+	cases := []struct {
+		name          string
+		theta, lambda float64
+	}{
+		{name: "theta=0", theta: 0, lambda: 0.5},
+		{name: "lambda=1", theta: 1, lambda: 1},
+		{name: "lambda=1.5", theta: 1, lambda: 1.5},
+		{name: "lambda=-1.5", theta: 10, lambda: -1.5},
+		{name: "lambda<-theta/4", theta: 2, lambda: -0.6},
+		{name: "lambda=NaN", theta: 2, lambda: math.NaN()},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := GeneralizedPoisson(tc.theta, tc.lambda); err == nil {
+				t.Errorf("expected an error, instead got nil")
+			}
+			if v := GeneralizedPoissonMean(tc.theta, tc.lambda); !math.IsNaN(v) {
+				t.Errorf("expected mean to be NaN, instead got %f", v)
+			}
+			if v := GeneralizedPoissonVariance(tc.theta, tc.lambda); !math.IsNaN(v) {
+				t.Errorf("expected variance to be NaN, instead got %f", v)
+			}
+		})
+	}
+}
+
+func TestGeneralizedPoissonLogPMF(t *testing.T) {
+	epsilon := 1e-10
+	// Probabilities sum to 1 when lambda >= 0
+	var total float64
+	for k := 0; k < 1000; k++ {
+		total += math.Exp(GeneralizedPoissonLogPMF(k, 2.0, 0.5))
+	}
+	if math.Abs(total-1) > epsilon {
+		t.Errorf("PMF sums to %f instead of 1", total)
+	}
+	// lambda = 0 reduces to the Poisson distribution
+	for k := 0; k < 20; k++ {
+		v := math.Exp(GeneralizedPoissonLogPMF(k, 4.0, 0.0))
+		expected := PoissonPMF(k, 4.0)
+		if math.Abs(v-expected) > epsilon {
+			t.Errorf("expected value is %e, instead got %e", expected, v)
+		}
+	}
+	// Outside the support
+	if v := GeneralizedPoissonLogPMF(60, 10.0, -0.2); !math.IsInf(v, -1) {
+		t.Errorf("expected negative infinity, instead got %e", v)
+	}
+}
@@ -0,0 +1,152 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// UniformDisk fills dst with points uniformly distributed in the disk with
+// the given center and radius, and returns dst. Each point is placed at a
+// uniform angle and at a distance of radius * sqrt(U) from the center so
+// that the density is constant over the area of the disk.
+func UniformDisk(dst [][2]float64, center [2]float64, radius float64) [][2]float64 {
+	for i := range dst {
+		r := radius * math.Sqrt(rand.Float64())
+		theta := 2 * math.Pi * rand.Float64()
+		dst[i] = [2]float64{center[0] + r*math.Cos(theta), center[1] + r*math.Sin(theta)}
+	}
+	return dst
+}
+
+// UniformTriangle fills dst with points uniformly distributed in the
+// triangle with vertices a, b and c, and returns dst. A uniform point in
+// the parallelogram spanned by the edges from a is reflected back into the
+// triangle when it falls in the other half.
+func UniformTriangle(dst [][2]float64, a, b, c [2]float64) [][2]float64 {
+	for i := range dst {
+		u, v := rand.Float64(), rand.Float64()
+		if u+v > 1 {
+			u, v = 1-u, 1-v
+		}
+		dst[i] = [2]float64{
+			a[0] + u*(b[0]-a[0]) + v*(c[0]-a[0]),
+			a[1] + u*(b[1]-a[1]) + v*(c[1]-a[1]),
+		}
+	}
+	return dst
+}
+
+// UniformPolygon fills dst with points uniformly distributed inside a
+// simple polygon, and returns dst. The polygon is given as a list of
+// vertices in order, either clockwise or counter-clockwise, without
+// repeating the first vertex at the end.
+// Points are placed by rejection sampling from the bounding box of the
+// polygon, so the expected number of candidates per point is the ratio of
+// the area of the bounding box to the area of the polygon.
+func UniformPolygon(dst [][2]float64, polygon [][2]float64) [][2]float64 {
+	// Compute bounding box
+	xmin, ymin := math.Inf(1), math.Inf(1)
+	xmax, ymax := math.Inf(-1), math.Inf(-1)
+	for _, v := range polygon {
+		xmin, xmax = math.Min(xmin, v[0]), math.Max(xmax, v[0])
+		ymin, ymax = math.Min(ymin, v[1]), math.Max(ymax, v[1])
+	}
+	for i := range dst {
+		for {
+			pt := [2]float64{Uniform(xmin, xmax), Uniform(ymin, ymax)}
+			if insidePolygon(pt, polygon) {
+				dst[i] = pt
+				break
+			}
+		}
+	}
+	return dst
+}
+
+// UniformInSimplex fills the flat buffer dst with points uniformly
+// distributed inside the simplex spanned by the given vertices, and returns
+// dst. All vertices must have the same number of coordinates d, and the
+// points are stored one after the other, d coordinates each, so dst holds
+// len(dst)/d points. Any remaining elements are left unchanged.
+// Each point is a convex combination of the vertices with weights drawn
+// uniformly from the probability simplex.
+func UniformInSimplex(dst []float64, vertices [][]float64) []float64 {
+	if len(vertices) == 0 {
+		return dst
+	}
+	d := len(vertices[0])
+	if d == 0 {
+		return dst
+	}
+	w := make([]float64, len(vertices))
+	for start := 0; start+d <= len(dst); start += d {
+		UniformSimplex(w, len(vertices))
+		pt := dst[start : start+d]
+		for j := range pt {
+			pt[j] = 0
+		}
+		for k, v := range vertices {
+			for j := range pt {
+				pt[j] += w[k] * v[j]
+			}
+		}
+	}
+	return dst
+}
+
+// RandomOrthogonal draws an n by n orthogonal matrix from the Haar
+// measure, the uniform distribution over orthogonal matrices. The matrix
+// is the Q factor of the QR decomposition of a matrix of standard normal
+// variates, where R is taken to have a positive diagonal (Mezzadri 2007).
+func RandomOrthogonal(n int) Matrix {
+	q := NewMatrix(n)
+	col := make([]float64, n)
+	// Orthonormalize random normal columns by modified Gram-Schmidt
+	for j := 0; j < n; j++ {
+		for {
+			for i := range col {
+				col[i] = rand.NormFloat64()
+			}
+			// Orthogonalize twice to keep the columns orthogonal to
+			// working precision
+			for pass := 0; pass < 2; pass++ {
+				for k := 0; k < j; k++ {
+					var dot float64
+					for i := range col {
+						dot += q[i][k] * col[i]
+					}
+					for i := range col {
+						col[i] -= dot * q[i][k]
+					}
+				}
+			}
+			var norm float64
+			for _, v := range col {
+				norm += v * v
+			}
+			// Redraw in the unlikely event that the column is degenerate
+			if norm > 1e-20 {
+				norm = math.Sqrt(norm)
+				for i, v := range col {
+					q[i][j] = v / norm
+				}
+				break
+			}
+		}
+	}
+	return q
+}
+
+// RandomRotation draws an n by n rotation matrix, an orthogonal matrix
+// with determinant 1, from the Haar measure. A random orthogonal matrix
+// with determinant -1 is turned into a rotation by negating its first
+// column.
+func RandomRotation(n int) Matrix {
+	q := RandomOrthogonal(n)
+	if n > 0 && determinant(q) < 0 {
+		for i := range q {
+			q[i][0] = -q[i][0]
+		}
+	}
+	return q
+}
@@ -0,0 +1,225 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestUniformDisk(t *testing.T) {
+	center := [2]float64{1, -2}
+	radius := 3.0
+	iterations := 20000
+	errSize := 0.02
+	rand.Seed(0)
+	points := UniformDisk(make([][2]float64, iterations), center, radius)
+	var inner int
+	var sumX, sumY float64
+	for _, pt := range points {
+		r := math.Hypot(pt[0]-center[0], pt[1]-center[1])
+		if r > radius {
+			t.Fatalf("point %v is outside the disk", pt)
+		}
+		if r < radius/2 {
+			inner++
+		}
+		sumX += pt[0]
+		sumY += pt[1]
+	}
+	if freq := float64(inner) / float64(iterations); 0.25+errSize < freq || 0.25-errSize > freq {
+		t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, 0.25, errSize)
+	}
+	for i, sum := range []float64{sumX, sumY} {
+		mean := sum / float64(iterations)
+		if err := errSize * radius; center[i]+err < mean || center[i]-err > mean {
+			t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, center[i], err)
+		}
+	}
+}
+
+func TestUniformTriangle(t *testing.T) {
+	a, b, c := [2]float64{0, 0}, [2]float64{4, 0}, [2]float64{1, 3}
+	iterations := 20000
+	errSize := 0.02
+	rand.Seed(0)
+	points := UniformTriangle(make([][2]float64, iterations), a, b, c)
+	// The medial triangle has a quarter of the area
+	mab := [2]float64{(a[0] + b[0]) / 2, (a[1] + b[1]) / 2}
+	mbc := [2]float64{(b[0] + c[0]) / 2, (b[1] + c[1]) / 2}
+	mca := [2]float64{(c[0] + a[0]) / 2, (c[1] + a[1]) / 2}
+	triangle := [][2]float64{a, b, c}
+	medial := [][2]float64{mab, mbc, mca}
+	var inner int
+	var sumX, sumY float64
+	for _, pt := range points {
+		if !insidePolygon(pt, triangle) {
+			t.Fatalf("point %v is outside the triangle", pt)
+		}
+		if insidePolygon(pt, medial) {
+			inner++
+		}
+		sumX += pt[0]
+		sumY += pt[1]
+	}
+	if freq := float64(inner) / float64(iterations); 0.25+errSize < freq || 0.25-errSize > freq {
+		t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, 0.25, errSize)
+	}
+	centroid := [2]float64{(a[0] + b[0] + c[0]) / 3, (a[1] + b[1] + c[1]) / 3}
+	for i, sum := range []float64{sumX, sumY} {
+		mean := sum / float64(iterations)
+		if centroid[i]+errSize < mean || centroid[i]-errSize > mean {
+			t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, centroid[i], errSize)
+		}
+	}
+}
+
+func TestUniformPolygon(t *testing.T) {
+	// L-shaped polygon made of three unit squares
+	polygon := [][2]float64{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}
+	iterations := 20000
+	errSize := 0.02
+	rand.Seed(0)
+	points := UniformPolygon(make([][2]float64, iterations), polygon)
+	var corner int
+	var sumX, sumY float64
+	for _, pt := range points {
+		if !insidePolygon(pt, polygon) {
+			t.Fatalf("point %v is outside the polygon", pt)
+		}
+		if pt[0] < 1 && pt[1] < 1 {
+			corner++
+		}
+		sumX += pt[0]
+		sumY += pt[1]
+	}
+	if freq := float64(corner) / float64(iterations); 1.0/3+errSize < freq || 1.0/3-errSize > freq {
+		t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, 1.0/3, errSize)
+	}
+	for _, sum := range []float64{sumX, sumY} {
+		mean := sum / float64(iterations)
+		if expected := 2.5 / 3; expected+errSize < mean || expected-errSize > mean {
+			t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, errSize)
+		}
+	}
+}
+
+func TestUniformInSimplex(t *testing.T) {
+	// Tetrahedron with one vertex at the origin and the others on the axes
+	vertices := [][]float64{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	iterations := 20000
+	errSize := 0.01
+	rand.Seed(0)
+	// One element more than needed should be left unchanged
+	dst := make([]float64, 3*iterations+1)
+	dst[len(dst)-1] = -1
+	UniformInSimplex(dst, vertices)
+	if dst[len(dst)-1] != -1 {
+		t.Errorf("expected trailing element to be unchanged, instead got %f", dst[len(dst)-1])
+	}
+	var inner int
+	sum := make([]float64, 3)
+	for i := 0; i < iterations; i++ {
+		pt := dst[3*i : 3*i+3]
+		var total float64
+		for j, v := range pt {
+			if v < 0 {
+				t.Fatalf("point %v is outside the simplex", pt)
+			}
+			total += v
+			sum[j] += v
+		}
+		if total > 1+1e-12 {
+			t.Fatalf("point %v is outside the simplex", pt)
+		}
+		if total < 0.5 {
+			inner++
+		}
+	}
+	// The simplex scaled by 1/2 has 1/8 of the volume
+	if freq := float64(inner) / float64(iterations); 0.125+errSize < freq || 0.125-errSize > freq {
+		t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, 0.125, errSize)
+	}
+	for j := range sum {
+		mean := sum[j] / float64(iterations)
+		if 0.25+errSize < mean || 0.25-errSize > mean {
+			t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, 0.25, errSize)
+		}
+	}
+}
+
+func TestRandomOrthogonal(t *testing.T) {
+	cases := []struct {
+		name     string
+		n        int
+		rotation bool
+		// second moment of the trace
+		traceSq float64
+	}{
+		{name: "orthogonal,n=2", n: 2, traceSq: 1},
+		{name: "orthogonal,n=5", n: 5, traceSq: 1},
+		{name: "rotation,n=2", n: 2, rotation: true, traceSq: 2},
+		{name: "rotation,n=3", n: 3, rotation: true, traceSq: 1},
+	}
+	iterations := 10000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var negative int
+			var sumTrace, sumTraceSq, sumSq float64
+			for i := 0; i < iterations; i++ {
+				var q Matrix
+				if tc.rotation {
+					q = RandomRotation(tc.n)
+				} else {
+					q = RandomOrthogonal(tc.n)
+				}
+				// Check Q^T Q = I
+				for j := 0; j < tc.n; j++ {
+					for k := 0; k < tc.n; k++ {
+						var v float64
+						for l := 0; l < tc.n; l++ {
+							v += q[l][j] * q[l][k]
+						}
+						expected := 0.0
+						if j == k {
+							expected = 1
+						}
+						if math.Abs(v-expected) > 1e-12 {
+							t.Fatalf("matrix is not orthogonal")
+						}
+					}
+				}
+				if determinant(q) < 0 {
+					negative++
+				}
+				var trace float64
+				for j := range q {
+					trace += q[j][j]
+				}
+				sumTrace += trace
+				sumTraceSq += trace * trace
+				sumSq += q[0][0] * q[0][0]
+			}
+			freq := float64(negative) / float64(iterations)
+			expected := 0.5
+			if tc.rotation {
+				expected = 0
+			}
+			if expected+errSize < freq || expected-errSize > freq {
+				t.Errorf("frequency of determinant -1 (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+			}
+			// Under the Haar measure, each entry has second moment 1/n
+			// and the trace has mean 0
+			if m := sumSq / float64(iterations); 1/float64(tc.n)+errSize < m || 1/float64(tc.n)-errSize > m {
+				t.Errorf("second moment of entry (%f) is greater than expected (%f) +/- (%f)", m, 1/float64(tc.n), errSize)
+			}
+			if m := sumTrace / float64(iterations); errSize < m || -errSize > m {
+				t.Errorf("mean trace (%f) is greater than expected (%f) +/- (%f)", m, 0.0, errSize)
+			}
+			if m := sumTraceSq / float64(iterations); tc.traceSq+2*errSize < m || tc.traceSq-2*errSize > m {
+				t.Errorf("second moment of trace (%f) is greater than expected (%f) +/- (%f)", m, tc.traceSq, 2*errSize)
+			}
+		})
+	}
+}
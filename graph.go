@@ -0,0 +1,242 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// ErdosRenyi returns the edge list of a random graph on n nodes in which
+// each of the n(n-1)/2 possible edges is present independently with
+// probability p. Each edge is given as a pair of node indices {u, v} with
+// u < v.
+// Uses the geometric skipping method of Batagelj and Brandes (2005): the
+// number of absent edges between consecutive present edges is geometrically
+// distributed, so the run time is proportional to the number of edges
+// rather than the number of node pairs.
+func ErdosRenyi(n int, p float64) [][2]int {
+	var edges [][2]int
+	skipPairs(n*(n-1)/2, p, func(idx int) {
+		edges = append(edges, pairFromIndex(idx))
+	})
+	return edges
+}
+
+// StochasticBlockModel returns the edge list of a random graph whose nodes
+// are divided into consecutive blocks of the given sizes. An edge between
+// a node in block a and a node in block b is present independently with
+// probability p[a][b], where p must be symmetric. Each edge is given as a
+// pair of node indices {u, v} with u < v.
+// Edges within and between each pair of blocks are drawn by geometric
+// skipping as in ErdosRenyi.
+func StochasticBlockModel(sizes []int, p [][]float64) [][2]int {
+	offsets := make([]int, len(sizes))
+	for a := 1; a < len(sizes); a++ {
+		offsets[a] = offsets[a-1] + sizes[a-1]
+	}
+	var edges [][2]int
+	for a := range sizes {
+		// Edges within block a
+		skipPairs(sizes[a]*(sizes[a]-1)/2, p[a][a], func(idx int) {
+			e := pairFromIndex(idx)
+			edges = append(edges, [2]int{offsets[a] + e[0], offsets[a] + e[1]})
+		})
+		// Edges between block a and each later block b
+		for b := a + 1; b < len(sizes); b++ {
+			nb := sizes[b]
+			skipPairs(sizes[a]*nb, p[a][b], func(idx int) {
+				edges = append(edges, [2]int{offsets[a] + idx/nb, offsets[b] + idx%nb})
+			})
+		}
+	}
+	return edges
+}
+
+// skipPairs calls fn with the index of each of total candidate edges that
+// is present, where each edge is present independently with probability
+// p. Indices are visited in increasing order by skipping over a
+// geometrically distributed number of absent edges at a time.
+func skipPairs(total int, p float64, fn func(idx int)) {
+	if p <= 0 || total <= 0 {
+		return
+	} else if p >= 1 {
+		for idx := 0; idx < total; idx++ {
+			fn(idx)
+		}
+		return
+	}
+	logQ := math.Log1p(-p)
+	idx := -1
+	for {
+		skip := math.Floor(math.Log(openUniform()) / logQ)
+		if skip >= float64(total-idx-1) {
+			return
+		}
+		idx += int(skip) + 1
+		fn(idx)
+	}
+}
+
+// pairFromIndex converts an index into the lexicographic enumeration of
+// the pairs {u, v} with u < v, ordered by v and then by u, into the pair.
+func pairFromIndex(idx int) [2]int {
+	v := int((1 + math.Sqrt(1+8*float64(idx))) / 2)
+	// Correct for rounding errors in the square root
+	for v*(v-1)/2 > idx {
+		v--
+	}
+	for (v+1)*v/2 <= idx {
+		v++
+	}
+	return [2]int{idx - v*(v-1)/2, v}
+}
+
+// BarabasiAlbert returns the edge list of a random graph on n nodes grown
+// by preferential attachment. The graph starts as a complete graph on m+1
+// nodes, and each subsequent node is connected to m distinct existing
+// nodes chosen with probability proportional to their degree. Each edge is
+// given as a pair of node indices {u, v} with u < v. Requires
+// 1 <= m < n.
+func BarabasiAlbert(n, m int) [][2]int {
+	edges := make([][2]int, 0, m*(m+1)/2+(n-m-1)*m)
+	// Every node appears in this list once per incident edge, so a
+	// uniform element is a node chosen proportional to its degree
+	endpoints := make([]int, 0, 2*cap(edges))
+	for v := 1; v <= m && v < n; v++ {
+		for u := 0; u < v; u++ {
+			edges = append(edges, [2]int{u, v})
+			endpoints = append(endpoints, u, v)
+		}
+	}
+	targets := make([]int, 0, m)
+	for v := m + 1; v < n; v++ {
+		targets = targets[:0]
+		for len(targets) < m {
+			u := endpoints[rand.Intn(len(endpoints))]
+			if !containsInt(targets, u) {
+				targets = append(targets, u)
+			}
+		}
+		for _, u := range targets {
+			edges = append(edges, [2]int{u, v})
+			endpoints = append(endpoints, u, v)
+		}
+	}
+	return edges
+}
+
+// WattsStrogatz returns the edge list of a small-world random graph on n
+// nodes. The graph starts as a ring lattice where each node is connected
+// to its k/2 nearest neighbors on each side, and each edge is then rewired
+// with probability beta to a uniformly chosen node, avoiding self-loops
+// and duplicate edges. Each edge is given as a pair of node indices {u, v}
+// with u < v. Requires k to be even and less than n.
+func WattsStrogatz(n, k int, beta float64) [][2]int {
+	edges := make([][2]int, 0, n*k/2)
+	present := make(map[[2]int]bool, n*k/2)
+	for j := 1; j <= k/2; j++ {
+		for u := 0; u < n; u++ {
+			e := orderedEdge(u, (u+j)%n)
+			edges = append(edges, e)
+			present[e] = true
+		}
+	}
+	degree := make([]int, n)
+	for _, e := range edges {
+		degree[e[0]]++
+		degree[e[1]]++
+	}
+	for i := range edges {
+		if rand.Float64() >= beta {
+			continue
+		}
+		// Keep the first endpoint of the lattice edge and move the other
+		u := i % n
+		if degree[u] >= n-1 {
+			// u is already connected to every other node
+			continue
+		}
+		var e [2]int
+		for {
+			w := rand.Intn(n)
+			e = orderedEdge(u, w)
+			if w != u && !present[e] {
+				break
+			}
+		}
+		old := edges[i]
+		delete(present, old)
+		degree[old[0]+old[1]-u]--
+		degree[e[0]+e[1]-u]++
+		present[e] = true
+		edges[i] = e
+	}
+	return edges
+}
+
+// containsInt checks whether x is an element of s.
+func containsInt(s []int, x int) bool {
+	for _, v := range s {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+// orderedEdge returns the edge between u and v with the smaller index
+// first.
+func orderedEdge(u, v int) [2]int {
+	if u > v {
+		return [2]int{v, u}
+	}
+	return [2]int{u, v}
+}
+
+// ConfigurationModel returns the edge list of a random multigraph in which
+// node i has degree degrees[i]. Each node is given degrees[i] half-edges,
+// or stubs, and the stubs are paired uniformly at random. The result may
+// contain self-loops and multiple edges between the same pair of nodes. If
+// the sum of the degrees is odd, one stub is left unpaired.
+func ConfigurationModel(degrees []int) [][2]int {
+	var stubs []int
+	for v, d := range degrees {
+		for i := 0; i < d; i++ {
+			stubs = append(stubs, v)
+		}
+	}
+	Shuffle(len(stubs), func(i, j int) { stubs[i], stubs[j] = stubs[j], stubs[i] })
+	edges := make([][2]int, len(stubs)/2)
+	for i := range edges {
+		edges[i] = orderedEdge(stubs[2*i], stubs[2*i+1])
+	}
+	return edges
+}
+
+// DegreeSequence draws n node degrees from the distribution dist for use
+// with ConfigurationModel. Each draw is rounded down to an integer and
+// negative draws are set to 0. If the degrees sum to an odd number, the
+// degree of a uniformly chosen node is increased by one so that every stub
+// can be paired. For example, Poisson degrees with a mean of 4 can be
+// drawn with
+//
+//	DegreeSequence(n, DistributionFunc(func() float64 { return float64(Poisson(4)) }))
+//
+// and power-law degrees with exponent alpha + 1 with
+//
+//	DegreeSequence(n, DistributionFunc(func() float64 { return Pareto(1, alpha) }))
+func DegreeSequence(n int, dist Distribution) []int {
+	degrees := make([]int, n)
+	var sum int
+	for i := range degrees {
+		d := int(math.Floor(dist.Rand()))
+		if d < 0 {
+			d = 0
+		}
+		degrees[i] = d
+		sum += d
+	}
+	if sum%2 == 1 {
+		degrees[rand.Intn(n)]++
+	}
+	return degrees
+}
@@ -0,0 +1,232 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// checkSimpleGraph checks that every edge joins two distinct nodes in
+// [0, n) with the smaller index first and that no edge is repeated.
+func checkSimpleGraph(t *testing.T, n int, edges [][2]int) {
+	seen := make(map[[2]int]bool, len(edges))
+	for _, e := range edges {
+		if e[0] < 0 || e[1] >= n || e[0] >= e[1] {
+			t.Fatalf("invalid edge %v", e)
+		}
+		if seen[e] {
+			t.Fatalf("duplicate edge %v", e)
+		}
+		seen[e] = true
+	}
+}
+
+func TestPairFromIndex(t *testing.T) {
+	idx := 0
+	for v := 1; v < 200; v++ {
+		for u := 0; u < v; u++ {
+			if e := pairFromIndex(idx); e != [2]int{u, v} {
+				t.Fatalf("expected pair %v for index %d, instead got %v", [2]int{u, v}, idx, e)
+			}
+			idx++
+		}
+	}
+}
+
+func TestErdosRenyi(t *testing.T) {
+	cases := []struct {
+		name string
+		n    int
+		p    float64
+	}{
+		{name: "n=0,p=0.5", n: 0, p: 0.5},
+		{name: "n=100,p=0", n: 100, p: 0},
+		{name: "n=100,p=0.01", n: 100, p: 0.01},
+		{name: "n=50,p=0.5", n: 50, p: 0.5},
+		{name: "n=30,p=1", n: 30, p: 1},
+	}
+	iterations := 200
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pairs := tc.n * (tc.n - 1) / 2
+			var sum int
+			for i := 0; i < iterations; i++ {
+				edges := ErdosRenyi(tc.n, tc.p)
+				checkSimpleGraph(t, tc.n, edges)
+				sum += len(edges)
+			}
+			if pairs == 0 {
+				if sum != 0 {
+					t.Errorf("expected no edges, instead got %d", sum)
+				}
+				return
+			}
+			freq := float64(sum) / float64(iterations*pairs)
+			if tc.p+errSize*tc.p < freq || tc.p-errSize*tc.p > freq {
+				t.Errorf("edge frequency (%f) is greater than expected (%f) +/- (%f)", freq, tc.p, errSize*tc.p)
+			}
+		})
+	}
+}
+
+func TestStochasticBlockModel(t *testing.T) {
+	sizes := []int{40, 25, 10}
+	p := [][]float64{
+		{0.3, 0.02, 0},
+		{0.02, 0.5, 0.1},
+		{0, 0.1, 1},
+	}
+	iterations := 200
+	errSize := 0.05
+	rand.Seed(0)
+	block := make([]int, 0, 75)
+	for a, size := range sizes {
+		for i := 0; i < size; i++ {
+			block = append(block, a)
+		}
+	}
+	counts := [3][3]int{}
+	for i := 0; i < iterations; i++ {
+		edges := StochasticBlockModel(sizes, p)
+		checkSimpleGraph(t, len(block), edges)
+		for _, e := range edges {
+			a, b := block[e[0]], block[e[1]]
+			counts[a][b]++
+		}
+	}
+	for a := range sizes {
+		for b := a; b < len(sizes); b++ {
+			pairs := sizes[a] * sizes[b]
+			if a == b {
+				pairs = sizes[a] * (sizes[a] - 1) / 2
+			}
+			freq := float64(counts[a][b]) / float64(iterations*pairs)
+			if err := errSize * p[a][b]; p[a][b]+err < freq || p[a][b]-err > freq {
+				t.Errorf("edge frequency (%f) between blocks %d and %d is greater than expected (%f) +/- (%f)", freq, a, b, p[a][b], err)
+			}
+		}
+	}
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	n, m := 2000, 3
+	rand.Seed(0)
+	edges := BarabasiAlbert(n, m)
+	checkSimpleGraph(t, n, edges)
+	if expected := m*(m+1)/2 + (n-m-1)*m; len(edges) != expected {
+		t.Fatalf("expected %d edges, instead got %d", expected, len(edges))
+	}
+	degree := make([]int, n)
+	for _, e := range edges {
+		degree[e[0]]++
+		degree[e[1]]++
+	}
+	var maxDegree int
+	for v, d := range degree {
+		if d < m {
+			t.Fatalf("expected node %d to have degree at least %d, instead got %d", v, m, d)
+		}
+		if d > maxDegree {
+			maxDegree = d
+		}
+	}
+	// Preferential attachment produces hubs with degrees far above the mean
+	if maxDegree < 10*2*m {
+		t.Errorf("expected a hub with degree at least %d, instead the maximum is %d", 10*2*m, maxDegree)
+	}
+}
+
+func TestWattsStrogatz(t *testing.T) {
+	cases := []struct {
+		name string
+		n, k int
+		beta float64
+	}{
+		{name: "n=100,k=4,beta=0", n: 100, k: 4, beta: 0},
+		{name: "n=100,k=4,beta=0.2", n: 100, k: 4, beta: 0.2},
+		{name: "n=100,k=6,beta=1", n: 100, k: 6, beta: 1},
+		{name: "n=5,k=4,beta=1", n: 5, k: 4, beta: 1},
+	}
+	iterations := 100
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rewired int
+			for i := 0; i < iterations; i++ {
+				edges := WattsStrogatz(tc.n, tc.k, tc.beta)
+				checkSimpleGraph(t, tc.n, edges)
+				if len(edges) != tc.n*tc.k/2 {
+					t.Fatalf("expected %d edges, instead got %d", tc.n*tc.k/2, len(edges))
+				}
+				for _, e := range edges {
+					d := e[1] - e[0]
+					if d > tc.k/2 && d < tc.n-tc.k/2 {
+						rewired++
+					}
+				}
+			}
+			// A rewired edge lands back on the lattice with a small
+			// probability, so this slightly underestimates beta
+			freq := float64(rewired) / float64(iterations*tc.n*tc.k/2)
+			expected := tc.beta * (1 - float64(tc.k)/float64(tc.n))
+			if tc.k >= tc.n-1 {
+				expected = 0
+			}
+			if expected+errSize < freq || expected-errSize > freq {
+				t.Errorf("fraction of long-range edges (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+			}
+		})
+	}
+}
+
+func TestConfigurationModel(t *testing.T) {
+	rand.Seed(0)
+	degrees := []int{3, 1, 0, 4, 2, 2, 5, 1}
+	for i := 0; i < 100; i++ {
+		edges := ConfigurationModel(degrees)
+		degree := make([]int, len(degrees))
+		for _, e := range edges {
+			if e[0] > e[1] {
+				t.Fatalf("expected the smaller index first, instead got %v", e)
+			}
+			degree[e[0]]++
+			degree[e[1]]++
+		}
+		for v := range degrees {
+			if degree[v] != degrees[v] {
+				t.Fatalf("expected node %d to have degree %d, instead got %d", v, degrees[v], degree[v])
+			}
+		}
+	}
+}
+
+func TestDegreeSequence(t *testing.T) {
+	n := 10001
+	errSize := 0.05
+	rand.Seed(0)
+	degrees := DegreeSequence(n, DistributionFunc(func() float64 { return float64(Poisson(4)) }))
+	var sum int
+	for _, d := range degrees {
+		sum += d
+	}
+	if sum%2 != 0 {
+		t.Errorf("expected an even degree sum, instead got %d", sum)
+	}
+	if mean := float64(sum) / float64(n); 4+errSize*4 < mean || 4-errSize*4 > mean {
+		t.Errorf("mean degree (%f) is greater than expected (%f) +/- (%f)", mean, 4.0, errSize*4)
+	}
+	// Constant odd degrees on an odd number of nodes need a correction
+	degrees = DegreeSequence(5, DistributionFunc(func() float64 { return 1 }))
+	sum = 0
+	for _, d := range degrees {
+		sum += d
+	}
+	if sum != 6 {
+		t.Errorf("expected a degree sum of 6, instead got %d", sum)
+	}
+	if len(ConfigurationModel(degrees)) != 3 {
+		t.Errorf("expected 3 edges")
+	}
+}
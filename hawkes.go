@@ -0,0 +1,162 @@
+package randomvariate
+
+import "math"
+
+// HawkesKernel is the excitation kernel of a Hawkes process. Eval returns
+// the increase in intensity at a time lag t >= 0 after an event. Kernels
+// are expected to be non-increasing in t so that the intensity just after
+// the current time bounds the intensity until the next event.
+type HawkesKernel interface {
+	Eval(t float64) float64
+}
+
+// ExponentialKernel is the kernel Alpha * Beta * exp(-Beta * t).
+// Alpha is the branching ratio, the expected number of direct offspring of
+// each event, and Beta is the rate at which the excitation decays.
+type ExponentialKernel struct {
+	Alpha float64
+	Beta  float64
+}
+
+// Eval returns the value of the kernel at time lag t.
+func (k ExponentialKernel) Eval(t float64) float64 {
+	return k.Alpha * k.Beta * math.Exp(-k.Beta*t)
+}
+
+// PowerLawKernel is the Omori-type kernel
+// Alpha * P * C^P / (t + C)^(1 + P).
+// Alpha is the branching ratio, C shifts the kernel to keep it finite at
+// t = 0, and P controls how heavy the tail of the kernel is.
+type PowerLawKernel struct {
+	Alpha float64
+	C     float64
+	P     float64
+}
+
+// Eval returns the value of the kernel at time lag t.
+func (k PowerLawKernel) Eval(t float64) float64 {
+	return k.Alpha * k.P * math.Pow(k.C, k.P) / math.Pow(t+k.C, 1+k.P)
+}
+
+// Hawkes simulates a self-exciting Hawkes process over the interval
+// [start, end) with background rate mu and excitation kernel kernel, using
+// Ogata's thinning algorithm.
+// Returns the sorted event times and, for each event, the index of the
+// event that triggered it. Background events (immigrants) have a parent
+// index of -1.
+// The process is stationary only when the branching ratio of the kernel is
+// less than 1.
+// For an ExponentialKernel, the intensity is updated recursively in
+// constant time per candidate event. Other kernels are evaluated against
+// every past event for each candidate, so a run with n events costs
+// O(n^2) kernel evaluations.
+func Hawkes(mu float64, kernel HawkesKernel, start, end float64) ([]float64, []int) {
+	if k, ok := kernel.(ExponentialKernel); ok {
+		return hawkesExponential(mu, k, start, end)
+	}
+
+	var times []float64
+	var parents []int
+	var contrib []float64
+
+	t := start
+	for {
+		// Intensity at the current time bounds the intensity until the
+		// next event since the kernel is non-increasing
+		lambdaBar := mu
+		for _, ti := range times {
+			lambdaBar += kernel.Eval(t - ti)
+		}
+		if lambdaBar <= 0 {
+			break
+		}
+		t += Exponential(lambdaBar)
+		if t >= end {
+			break
+		}
+
+		// Compute intensity at the candidate time
+		contrib = contrib[:0]
+		lambda := mu
+		for _, ti := range times {
+			v := kernel.Eval(t - ti)
+			contrib = append(contrib, v)
+			lambda += v
+		}
+
+		// Accept with probability lambda/lambdaBar
+		u := Uniform(0, lambdaBar)
+		if u >= lambda {
+			continue
+		}
+		// Conditional on acceptance, u is uniform over [0, lambda) and is
+		// reused to attribute the event to the background or to a parent
+		parent := -1
+		if u >= mu {
+			u -= mu
+			parent = len(contrib) - 1
+			for j, v := range contrib {
+				if u < v {
+					parent = j
+					break
+				}
+				u -= v
+			}
+		}
+		times = append(times, t)
+		parents = append(parents, parent)
+	}
+	return times, parents
+}
+
+// hawkesExponential simulates a Hawkes process with an exponential kernel
+// using Ogata's thinning algorithm. The excitation from all past events
+// decays by exp(-Beta * dt) over an interval of length dt and jumps by
+// Alpha * Beta at each event, so it is tracked as a single number instead
+// of being summed over the history.
+func hawkesExponential(mu float64, kernel ExponentialKernel, start, end float64) ([]float64, []int) {
+	var times []float64
+	var parents []int
+	jump := kernel.Alpha * kernel.Beta
+
+	// Excitation at the current time t
+	var excitation float64
+	t := start
+	for {
+		lambdaBar := mu + excitation
+		if lambdaBar <= 0 {
+			break
+		}
+		dt := Exponential(lambdaBar)
+		t += dt
+		if t >= end {
+			break
+		}
+		excitation *= math.Exp(-kernel.Beta * dt)
+
+		// Accept with probability lambda/lambdaBar
+		u := Uniform(0, lambdaBar)
+		if u >= mu+excitation {
+			continue
+		}
+		// Attribute the event by searching backwards from the most recent
+		// event, whose contribution to the excitation is the largest
+		parent := -1
+		if u >= mu {
+			u -= mu
+			parent = 0
+			for j := len(times) - 1; j >= 0; j-- {
+				v := kernel.Eval(t - times[j])
+				if u < v {
+					parent = j
+					break
+				}
+				u -= v
+			}
+		}
+		times = append(times, t)
+		parents = append(parents, parent)
+		excitation += jump
+	}
+	return times, parents
+}
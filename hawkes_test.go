@@ -0,0 +1,116 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// scanKernel hides the type of the kernel it wraps so that Hawkes
+// evaluates it against every past event.
+type scanKernel struct {
+	HawkesKernel
+}
+
+func TestHawkes(t *testing.T) {
+	cases := []struct {
+		name   string
+		mu     float64
+		kernel HawkesKernel
+		alpha  float64
+	}{
+		{name: "kernel=exponential,alpha=0",
+			mu:     1.0,
+			kernel: ExponentialKernel{Alpha: 0.0, Beta: 1.0},
+			alpha:  0.0,
+		},
+		{name: "kernel=exponential,alpha=0.5",
+			mu:     0.5,
+			kernel: ExponentialKernel{Alpha: 0.5, Beta: 2.0},
+			alpha:  0.5,
+		},
+		{name: "kernel=exponential,scan,alpha=0.5",
+			mu:     0.5,
+			kernel: scanKernel{ExponentialKernel{Alpha: 0.5, Beta: 2.0}},
+			alpha:  0.5,
+		},
+		{name: "kernel=power_law,alpha=0.3",
+			mu:     0.5,
+			kernel: PowerLawKernel{Alpha: 0.3, C: 0.5, P: 2.0},
+			alpha:  0.3,
+		},
+	}
+	iterations := 100
+	errSize := 0.1
+	end := 200.0
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var sum, immigrants int
+			for i := 0; i < iterations; i++ {
+				times, parents := Hawkes(tc.mu, tc.kernel, 0, end)
+				if len(times) != len(parents) {
+					t.Fatalf("number of times (%d) and parents (%d) differ", len(times), len(parents))
+				}
+				for j, p := range parents {
+					if j > 0 && times[j] < times[j-1] {
+						t.Fatalf("event times are not sorted")
+					}
+					if p == -1 {
+						immigrants++
+					} else if p < 0 || p >= j {
+						t.Fatalf("event %d has invalid parent %d", j, p)
+					}
+				}
+				sum += len(times)
+			}
+			// Check mean number of events against the stationary rate
+			mean := float64(sum) / float64(iterations)
+			expected := tc.mu * end / (1 - tc.alpha)
+			err := expected * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean count (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+			// Check fraction of background events
+			freq := float64(immigrants) / float64(sum)
+			expected = 1 - tc.alpha
+			if expected+errSize <= freq || expected-errSize >= freq {
+				t.Errorf("immigrant frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+			}
+		})
+	}
+}
+
+func TestHawkesParentLag(t *testing.T) {
+	cases := []struct {
+		name   string
+		kernel HawkesKernel
+	}{
+		{name: "kernel=exponential", kernel: ExponentialKernel{Alpha: 0.8, Beta: 2.0}},
+		{name: "kernel=exponential,scan", kernel: scanKernel{ExponentialKernel{Alpha: 0.8, Beta: 2.0}}},
+	}
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The lag between an event and its parent follows the
+			// normalized kernel, an exponential distribution with mean
+			// 1/Beta
+			var sum float64
+			var n int
+			for i := 0; i < 20; i++ {
+				times, parents := Hawkes(1, tc.kernel, 0, 200)
+				for j, p := range parents {
+					if p >= 0 {
+						sum += times[j] - times[p]
+						n++
+					}
+				}
+			}
+			mean := sum / float64(n)
+			if expected := 0.5; expected+errSize*expected < mean || expected-errSize*expected > mean {
+				t.Errorf("mean lag (%f) is greater than expected (%f) +/- (%f)", mean, expected, errSize*expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package randomvariate
+
+// HMM is a hidden Markov model with categorical emissions. Hidden states
+// follow a discrete-time Markov chain, and each hidden state emits a symbol
+// from its own categorical distribution.
+type HMM struct {
+	chain     *MarkovChain
+	emissions []*aliasTable
+}
+
+// NewHMM creates a hidden Markov model from the initial state distribution
+// p0, the K x K transition matrix A and the K x M emission matrix B, where
+// B[i][m] is the probability that hidden state i emits symbol m.
+// Samples are drawn from the global math/rand source, so sequences are
+// reproducible after a call to rand.Seed. In Go 1.24 and later, rand.Seed
+// is a no-op when the main module's go.mod declares go 1.24 or later,
+// unless the randseednop=0 GODEBUG setting restores the old behavior.
+func NewHMM(p0 []float64, A [][]float64, B [][]float64) *HMM {
+	emissions := make([]*aliasTable, len(B))
+	for i, row := range B {
+		emissions[i] = newAliasTable(row)
+	}
+	return &HMM{
+		chain:     NewMarkovChain(p0, A),
+		emissions: emissions,
+	}
+}
+
+// Sample generates a sequence of length T. Returns the hidden state path
+// and the emitted symbols.
+func (h *HMM) Sample(T int) ([]int, []int) {
+	states := h.chain.Path(T)
+	observations := make([]int, T)
+	for t, s := range states {
+		observations[t] = h.emissions[s].draw()
+	}
+	return states, observations
+}
+
+// ContinuousHMM is a hidden Markov model where each hidden state emits a
+// real-valued observation drawn from its own distribution. Samples are
+// reproducible under the same conditions as those of HMM; see NewHMM.
+type ContinuousHMM struct {
+	chain     *MarkovChain
+	emissions []Distribution
+}
+
+// NewContinuousHMM creates a hidden Markov model from the initial state
+// distribution p0, the K x K transition matrix A and the emission
+// distributions of each of the K hidden states.
+func NewContinuousHMM(p0 []float64, A [][]float64, emissions []Distribution) *ContinuousHMM {
+	return &ContinuousHMM{
+		chain:     NewMarkovChain(p0, A),
+		emissions: emissions,
+	}
+}
+
+// Sample generates a sequence of length T. Returns the hidden state path
+// and the emitted observations.
+func (h *ContinuousHMM) Sample(T int) ([]int, []float64) {
+	states := h.chain.Path(T)
+	observations := make([]float64, T)
+	for t, s := range states {
+		observations[t] = h.emissions[s].Rand()
+	}
+	return states, observations
+}
@@ -0,0 +1,137 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestHMM(t *testing.T) {
+	cases := []struct {
+		name string
+		p0   []float64
+		A    [][]float64
+		B    [][]float64
+	}{
+		{name: "states=2,symbols=2",
+			p0: []float64{0.5, 0.5},
+			A:  [][]float64{{0.9, 0.1}, {0.2, 0.8}},
+			B:  [][]float64{{0.8, 0.2}, {0.1, 0.9}},
+		},
+		{name: "states=2,symbols=4,dist=disjoint",
+			p0: []float64{1.0, 0.0},
+			A:  [][]float64{{0.5, 0.5}, {0.5, 0.5}},
+			B:  [][]float64{{0.5, 0.5, 0.0, 0.0}, {0.0, 0.0, 0.3, 0.7}},
+		},
+		{name: "states=3,symbols=3",
+			p0: []float64{0.2, 0.3, 0.5},
+			A:  [][]float64{{0.6, 0.2, 0.2}, {0.1, 0.8, 0.1}, {0.3, 0.3, 0.4}},
+			B:  [][]float64{{0.7, 0.2, 0.1}, {0.1, 0.7, 0.2}, {0.2, 0.1, 0.7}},
+		},
+	}
+	iterations := 100
+	T := 500
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHMM(tc.p0, tc.A, tc.B)
+			// Simulate and count emissions per hidden state
+			cnt := make([][]int, len(tc.B))
+			for i := range cnt {
+				cnt[i] = make([]int, len(tc.B[i]))
+			}
+			for i := 0; i < iterations; i++ {
+				states, observations := h.Sample(T)
+				if len(states) != T || len(observations) != T {
+					t.Fatalf("expected sequences of length %d, instead got %d and %d", T, len(states), len(observations))
+				}
+				for j, s := range states {
+					cnt[s][observations[j]]++
+				}
+			}
+			// Check emission frequency of each hidden state
+			for i, row := range cnt {
+				var total int
+				for _, v := range row {
+					total += v
+				}
+				for m, v := range row {
+					freq := float64(v) / float64(total)
+					expected := tc.B[i][m]
+					if expected+errSize < freq || expected-errSize > freq {
+						t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestContinuousHMM(t *testing.T) {
+	p0 := []float64{0.5, 0.5}
+	A := [][]float64{{0.9, 0.1}, {0.1, 0.9}}
+	means := []float64{-5.0, 5.0}
+	emissions := []Distribution{
+		DistributionFunc(func() float64 { return Uniform(-6, -4) }),
+		DistributionFunc(func() float64 { return Uniform(4, 6) }),
+	}
+	iterations := 100
+	T := 500
+	errSize := 0.05
+	rand.Seed(0)
+
+	h := NewContinuousHMM(p0, A, emissions)
+	sum := make([]float64, len(p0))
+	cnt := make([]int, len(p0))
+	for i := 0; i < iterations; i++ {
+		states, observations := h.Sample(T)
+		for j, s := range states {
+			if observations[j] < means[s]-1 || observations[j] >= means[s]+1 {
+				t.Fatalf("observation (%f) was not emitted by state %d", observations[j], s)
+			}
+			sum[s] += observations[j]
+			cnt[s]++
+		}
+	}
+	for i := range sum {
+		mean := sum[i] / float64(cnt[i])
+		if means[i]+errSize < mean || means[i]-errSize > mean {
+			t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, means[i], errSize)
+		}
+	}
+}
+
+func TestHMMDeterministic(t *testing.T) {
+	// rand.Seed is a no-op when GODEBUG=randseednop=1, the default for
+	// modules targeting Go 1.24 and later
+	rand.Seed(1)
+	x := rand.Int63()
+	rand.Seed(1)
+	if rand.Int63() != x {
+		t.Skip("rand.Seed has no effect")
+	}
+
+	p0 := []float64{0.6, 0.4}
+	A := [][]float64{{0.7, 0.3}, {0.4, 0.6}}
+	B := [][]float64{{0.1, 0.4, 0.5}, {0.6, 0.3, 0.1}}
+	h := NewHMM(p0, A, B)
+	c := NewContinuousHMM(p0, A, []Distribution{
+		DistributionFunc(func() float64 { return Normal(0, 1) }),
+		DistributionFunc(func() float64 { return Normal(5, 2) }),
+	})
+	sample := func() ([]int, []int, []int, []float64) {
+		rand.Seed(42)
+		states, observations := h.Sample(200)
+		cStates, cObservations := c.Sample(200)
+		return states, observations, cStates, cObservations
+	}
+	s1, o1, cs1, co1 := sample()
+	s2, o2, cs2, co2 := sample()
+	if !reflect.DeepEqual(s1, s2) || !reflect.DeepEqual(o1, o2) {
+		t.Errorf("expected identical categorical sequences for the same seed")
+	}
+	if !reflect.DeepEqual(cs1, cs2) || !reflect.DeepEqual(co1, co2) {
+		t.Errorf("expected identical continuous sequences for the same seed")
+	}
+}
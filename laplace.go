@@ -0,0 +1,33 @@
+package randomvariate
+
+import "math"
+
+// Laplace draws a sample from a Laplace distribution with location mu and
+// scale b by inversion.
+func Laplace(mu, b float64) float64 {
+	return LaplaceQuantile(openUniform(), mu, b)
+}
+
+// LaplacePDF returns the probability density of a Laplace distribution at
+// x.
+func LaplacePDF(x, mu, b float64) float64 {
+	return math.Exp(-math.Abs(x-mu)/b) / (2 * b)
+}
+
+// LaplaceCDF returns the cumulative probability of a Laplace distribution
+// at x.
+func LaplaceCDF(x, mu, b float64) float64 {
+	if x < mu {
+		return 0.5 * math.Exp((x-mu)/b)
+	}
+	return 1 - 0.5*math.Exp(-(x-mu)/b)
+}
+
+// LaplaceQuantile returns the value x at which the cumulative probability
+// of a Laplace distribution is p.
+func LaplaceQuantile(p, mu, b float64) float64 {
+	if p < 0.5 {
+		return mu + b*math.Log(2*p)
+	}
+	return mu - b*math.Log(2-2*p)
+}
@@ -0,0 +1,26 @@
+package randomvariate
+
+import "testing"
+
+func TestLaplace(t *testing.T) {
+	cases := []struct {
+		name  string
+		mu, b float64
+	}{
+		{name: "mu=0,b=1", mu: 0.0, b: 1.0},
+		{name: "mu=3,b=0.5", mu: 3.0, b: 0.5},
+		{name: "mu=-2,b=4", mu: -2.0, b: 4.0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Laplace(tc.mu, tc.b) }
+			cdf := func(x float64) float64 { return LaplaceCDF(x, tc.mu, tc.b) }
+			pdf := func(x float64) float64 { return LaplacePDF(x, tc.mu, tc.b) }
+			quantile := func(p float64) float64 { return LaplaceQuantile(p, tc.mu, tc.b) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
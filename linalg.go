@@ -0,0 +1,170 @@
+package randomvariate
+
+import "math"
+
+// cholesky computes the lower-triangular Cholesky factor L of the
+// symmetric positive definite matrix a such that a = L L^T. Returns false
+// if a is not positive definite.
+func cholesky(a [][]float64) ([][]float64, bool) {
+	n := len(a)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := a[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, false
+				}
+				l[i][i] = math.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, true
+}
+
+// symmetricEigen computes the eigenvalues and eigenvectors of the
+// symmetric matrix a using the cyclic Jacobi method. The eigenvector of
+// the i-th eigenvalue is stored in column i of the returned matrix.
+func symmetricEigen(a [][]float64) ([]float64, [][]float64) {
+	n := len(a)
+	// Work on a copy of a
+	m := make([][]float64, n)
+	v := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+		copy(m[i], a[i])
+		v[i] = make([]float64, n)
+		v[i][i] = 1
+	}
+
+	for sweep := 0; sweep < 100; sweep++ {
+		var off float64
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				off += m[i][j] * m[i][j]
+			}
+		}
+		if off < 1e-30 {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if m[p][q] == 0 {
+					continue
+				}
+				// Rotation that zeroes m[p][q]
+				theta := (m[q][q] - m[p][p]) / (2 * m[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					mkp, mkq := m[k][p], m[k][q]
+					m[k][p] = c*mkp - s*mkq
+					m[k][q] = s*mkp + c*mkq
+				}
+				for k := 0; k < n; k++ {
+					mpk, mqk := m[p][k], m[q][k]
+					m[p][k] = c*mpk - s*mqk
+					m[q][k] = s*mpk + c*mqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p] = c*vkp - s*vkq
+					v[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = m[i][i]
+	}
+	return values, v
+}
+
+// Matrix is a dense square matrix stored as a slice of rows. Because its
+// underlying type is [][]float64, a Matrix can be passed directly wherever
+// a covariance matrix is expected, such as NewMVNormal.
+type Matrix [][]float64
+
+// NewMatrix creates an n by n matrix of zeros.
+func NewMatrix(n int) Matrix {
+	m := make(Matrix, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+	}
+	return m
+}
+
+// Dim returns the number of rows of the matrix.
+func (m Matrix) Dim() int {
+	return len(m)
+}
+
+// invertLower computes the inverse of the lower-triangular matrix l by
+// forward substitution. The inverse is also lower-triangular.
+func invertLower(l [][]float64) [][]float64 {
+	n := len(l)
+	inv := make([][]float64, n)
+	for i := range inv {
+		inv[i] = make([]float64, n)
+	}
+	for j := 0; j < n; j++ {
+		inv[j][j] = 1 / l[j][j]
+		for i := j + 1; i < n; i++ {
+			var sum float64
+			for k := j; k < i; k++ {
+				sum -= l[i][k] * inv[k][j]
+			}
+			inv[i][j] = sum / l[i][i]
+		}
+	}
+	return inv
+}
+
+// determinant computes the determinant of the square matrix a by Gaussian
+// elimination with partial pivoting.
+func determinant(a [][]float64) float64 {
+	n := len(a)
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+		copy(m[i], a[i])
+	}
+	det := 1.0
+	for j := 0; j < n; j++ {
+		pivot := j
+		for i := j + 1; i < n; i++ {
+			if math.Abs(m[i][j]) > math.Abs(m[pivot][j]) {
+				pivot = i
+			}
+		}
+		if m[pivot][j] == 0 {
+			return 0
+		}
+		if pivot != j {
+			m[pivot], m[j] = m[j], m[pivot]
+			det = -det
+		}
+		det *= m[j][j]
+		for i := j + 1; i < n; i++ {
+			f := m[i][j] / m[j][j]
+			for k := j; k < n; k++ {
+				m[i][k] -= f * m[j][k]
+			}
+		}
+	}
+	return det
+}
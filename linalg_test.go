@@ -0,0 +1,91 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+var symmetricMatrixCases = []struct {
+	name string
+	a    [][]float64
+	pd   bool
+}{
+	{name: "dim=1", a: [][]float64{{4}}, pd: true},
+	{name: "dim=2", a: [][]float64{{2, 1}, {1, 2}}, pd: true},
+	{name: "dim=3", a: [][]float64{{4, 2, 0.6}, {2, 5, 1.5}, {0.6, 1.5, 3}}, pd: true},
+	{name: "dim=3,rank=2", a: [][]float64{{1, 1, 0}, {1, 1, 0}, {0, 0, 2}}, pd: false},
+	{name: "dim=2,indefinite", a: [][]float64{{1, 2}, {2, 1}}, pd: false},
+}
+
+func TestCholesky(t *testing.T) {
+	epsilon := 1e-12
+	for _, tc := range symmetricMatrixCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l, ok := cholesky(tc.a)
+			if ok != tc.pd {
+				t.Fatalf("expected positive definite to be %t, instead got %t", tc.pd, ok)
+			}
+			if !ok {
+				return
+			}
+			// Reconstruct a = L L^T
+			for i := range tc.a {
+				for j := range tc.a {
+					var v float64
+					for k := range l {
+						v += l[i][k] * l[j][k]
+					}
+					if math.Abs(v-tc.a[i][j]) > epsilon {
+						t.Errorf("expected value is %e, instead got %e", tc.a[i][j], v)
+					}
+					if j > i && l[i][j] != 0 {
+						t.Errorf("factor is not lower-triangular")
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSymmetricEigen(t *testing.T) {
+	epsilon := 1e-10
+	for _, tc := range symmetricMatrixCases {
+		t.Run(tc.name, func(t *testing.T) {
+			values, vectors := symmetricEigen(tc.a)
+			// Reconstruct a = V D V^T
+			for i := range tc.a {
+				for j := range tc.a {
+					var v float64
+					for k := range values {
+						v += vectors[i][k] * values[k] * vectors[j][k]
+					}
+					if math.Abs(v-tc.a[i][j]) > epsilon {
+						t.Errorf("expected value is %e, instead got %e", tc.a[i][j], v)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	cases := []struct {
+		name     string
+		a        [][]float64
+		expected float64
+	}{
+		{name: "dim=1", a: [][]float64{{-3}}, expected: -3},
+		{name: "dim=2", a: [][]float64{{1, 2}, {3, 4}}, expected: -2},
+		{name: "dim=3,pivot", a: [][]float64{{0, 1, 2}, {1, 0, 3}, {4, -3, 8}}, expected: -2},
+		{name: "dim=3,singular", a: [][]float64{{1, 2, 3}, {2, 4, 6}, {1, 0, 1}}, expected: 0},
+	}
+	epsilon := 1e-12
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := determinant(tc.a)
+			if math.Abs(v-tc.expected) > epsilon {
+				t.Errorf("expected value is %e, instead got %e", tc.expected, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// LKJCorrelation draws a random n by n correlation matrix from the
+// Lewandowski-Kurowicka-Joe distribution with shape eta > 0. The density
+// is proportional to det(R)^(eta-1), so eta = 1 is uniform over
+// correlation matrices, eta > 1 concentrates mass around the identity and
+// eta < 1 favors strong correlations.
+// Uses the onion method of Lewandowski, Kurowicka and Joe (2009), which
+// grows the matrix one row and column at a time.
+func LKJCorrelation(n int, eta float64) Matrix {
+	r := NewMatrix(n)
+	for i := range r {
+		r[i][i] = 1
+	}
+	if n < 2 {
+		return r
+	}
+
+	b := eta + float64(n-2)/2
+	r[0][1] = 2*betaVariate(b, b) - 1
+	r[1][0] = r[0][1]
+	w := make([]float64, n)
+	for k := 2; k < n; k++ {
+		b -= 0.5
+		y := betaVariate(float64(k)/2, b)
+
+		// Uniform direction on the unit sphere in k dimensions
+		var norm float64
+		for i := 0; i < k; i++ {
+			w[i] = rand.NormFloat64()
+			norm += w[i] * w[i]
+		}
+		scale := math.Sqrt(y / norm)
+		for i := 0; i < k; i++ {
+			w[i] *= scale
+		}
+
+		// The new column is A w, where A A^T is the current k by k block
+		block := make([][]float64, k)
+		for i := range block {
+			block[i] = r[i][:k]
+		}
+		z, ok := onionColumn(block, w[:k])
+		if !ok {
+			// Rounding has left the block clearly indefinite, so discard
+			// the partial matrix and start over
+			return LKJCorrelation(n, eta)
+		}
+		for i, v := range z {
+			r[i][k] = v
+			r[k][i] = v
+		}
+	}
+	return r
+}
+
+// onionColumn returns A w, where A A^T = block. Small eta pushes
+// correlations towards +/-1 and can leave the block singular to working
+// precision, so if the Cholesky factorization fails the factor is computed
+// from the eigendecomposition instead, as NewMVNormal does. Returns false
+// if the block is clearly indefinite.
+func onionColumn(block [][]float64, w []float64) ([]float64, bool) {
+	a, ok := cholesky(block)
+	if !ok {
+		var err error
+		if a, err = eigenFactor(block); err != nil {
+			return nil, false
+		}
+	}
+	z := make([]float64, len(w))
+	for i := range z {
+		for j, v := range w {
+			z[i] += a[i][j] * v
+		}
+	}
+	return z, true
+}
+
+// betaVariate draws a sample from a beta distribution with shape
+// parameters a and b as the ratio of two gamma variates. The gamma
+// variates are compared on the log scale because for small shapes both
+// can underflow to zero.
+func betaVariate(a, b float64) float64 {
+	logX := logGammaVariate(a)
+	logY := logGammaVariate(b)
+	return 1 / (1 + math.Exp(logY-logX))
+}
+
+// logGammaVariate draws the logarithm of a gamma variate with the given
+// shape and unit scale. Shapes less than 1 are handled as in Gamma, adding
+// log(U)/shape to the log of a draw with shape + 1.
+func logGammaVariate(shape float64) float64 {
+	if shape < 1 {
+		return math.Log(Gamma(shape+1, 1)) + math.Log(rand.Float64())/shape
+	}
+	return math.Log(Gamma(shape, 1))
+}
@@ -0,0 +1,105 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestLKJCorrelation(t *testing.T) {
+	cases := []struct {
+		name string
+		n    int
+		eta  float64
+	}{
+		{name: "n=1,eta=1", n: 1, eta: 1},
+		{name: "n=2,eta=1", n: 2, eta: 1},
+		{name: "n=3,eta=1", n: 3, eta: 1},
+		{name: "n=4,eta=2", n: 4, eta: 2},
+		{name: "n=5,eta=0.5", n: 5, eta: 0.5},
+	}
+	iterations := 20000
+	errSize := 0.02
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Each off-diagonal element r satisfies (r+1)/2 ~ Beta(a, a)
+			a := tc.eta - 1 + float64(tc.n)/2
+			expVariance := 1 / (2*a + 1)
+			var sum, sumSq float64
+			var count int
+			for i := 0; i < iterations; i++ {
+				r := LKJCorrelation(tc.n, tc.eta)
+				if r.Dim() != tc.n {
+					t.Fatalf("expected dimension %d, instead got %d", tc.n, r.Dim())
+				}
+				if tc.n > 1 {
+					if _, ok := cholesky(r); !ok {
+						t.Fatalf("sample is not positive definite")
+					}
+				}
+				for j := range r {
+					if r[j][j] != 1 {
+						t.Fatalf("expected diagonal to be 1, instead got %f", r[j][j])
+					}
+					for k := j + 1; k < tc.n; k++ {
+						if math.Abs(r[j][k]-r[k][j]) > 1e-12 {
+							t.Fatalf("sample is not symmetric")
+						}
+						sum += r[j][k]
+						sumSq += r[j][k] * r[j][k]
+						count++
+					}
+				}
+			}
+			if count == 0 {
+				return
+			}
+			mean := sum / float64(count)
+			variance := sumSq/float64(count) - mean*mean
+			if errSize < mean || -errSize > mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, 0.0, errSize)
+			}
+			if expVariance+errSize < variance || expVariance-errSize > variance {
+				t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, expVariance, errSize)
+			}
+		})
+	}
+}
+
+func TestLKJCorrelationSmallEta(t *testing.T) {
+	rand.Seed(0)
+	for _, n := range []int{2, 5, 30} {
+		for i := 0; i < 200; i++ {
+			r := LKJCorrelation(n, 0.001)
+			for j := range r {
+				for k := range r[j] {
+					if math.IsNaN(r[j][k]) || math.Abs(r[j][k]) > 1+1e-9 {
+						t.Fatalf("n=%d: expected a correlation, instead got %f", n, r[j][k])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestOnionColumnSingular(t *testing.T) {
+	// A singular block has no Cholesky factor
+	block := [][]float64{{1, 1, 0}, {1, 1, 0}, {0, 0, 1}}
+	w := []float64{0.6, 0.0, 0.8}
+	z, ok := onionColumn(block, w)
+	if !ok {
+		t.Fatalf("expected singular block to be factored")
+	}
+	// Any factor of the block has equal first and second rows, orthogonal
+	// to the unit-length third row
+	if math.Abs(z[0]-z[1]) > 1e-9 {
+		t.Errorf("expected equal coordinates, instead got %f and %f", z[0], z[1])
+	}
+	if v := z[0]*z[0] + z[2]*z[2]; v > 1+1e-9 {
+		t.Errorf("expected squared length at most 1, instead got %f", v)
+	}
+	if _, ok := onionColumn([][]float64{{1, 2}, {2, 1}}, []float64{1, 0}); ok {
+		t.Errorf("expected indefinite block to be rejected")
+	}
+}
@@ -0,0 +1,29 @@
+package randomvariate
+
+import "math"
+
+// Logistic draws a sample from a logistic distribution with location mu and
+// scale s by inversion.
+func Logistic(mu, s float64) float64 {
+	return LogisticQuantile(openUniform(), mu, s)
+}
+
+// LogisticPDF returns the probability density of a logistic distribution at
+// x.
+func LogisticPDF(x, mu, s float64) float64 {
+	// Symmetric form that does not overflow for large |x|
+	z := math.Exp(-math.Abs(x-mu) / s)
+	return z / (s * (1 + z) * (1 + z))
+}
+
+// LogisticCDF returns the cumulative probability of a logistic distribution
+// at x.
+func LogisticCDF(x, mu, s float64) float64 {
+	return 1 / (1 + math.Exp(-(x-mu)/s))
+}
+
+// LogisticQuantile returns the value x at which the cumulative probability
+// of a logistic distribution is p.
+func LogisticQuantile(p, mu, s float64) float64 {
+	return mu + s*math.Log(p/(1-p))
+}
@@ -0,0 +1,26 @@
+package randomvariate
+
+import "testing"
+
+func TestLogistic(t *testing.T) {
+	cases := []struct {
+		name  string
+		mu, s float64
+	}{
+		{name: "mu=0,s=1", mu: 0.0, s: 1.0},
+		{name: "mu=3,s=0.5", mu: 3.0, s: 0.5},
+		{name: "mu=-2,s=4", mu: -2.0, s: 4.0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Logistic(tc.mu, tc.s) }
+			cdf := func(x float64) float64 { return LogisticCDF(x, tc.mu, tc.s) }
+			pdf := func(x float64) float64 { return LogisticPDF(x, tc.mu, tc.s) }
+			quantile := func(p float64) float64 { return LogisticQuantile(p, tc.mu, tc.s) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
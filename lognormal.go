@@ -0,0 +1,33 @@
+package randomvariate
+
+import "math"
+
+// Lognormal draws a sample from a lognormal distribution, whose logarithm
+// is normally distributed with mean mu and standard deviation sigma.
+func Lognormal(mu, sigma float64) float64 {
+	return math.Exp(Normal(mu, sigma))
+}
+
+// LognormalPDF returns the probability density of a lognormal distribution
+// at x.
+func LognormalPDF(x, mu, sigma float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return NormalPDF(math.Log(x), mu, sigma) / x
+}
+
+// LognormalCDF returns the cumulative probability of a lognormal
+// distribution at x.
+func LognormalCDF(x, mu, sigma float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return NormalCDF(math.Log(x), mu, sigma)
+}
+
+// LognormalQuantile returns the value x at which the cumulative probability
+// of a lognormal distribution is p.
+func LognormalQuantile(p, mu, sigma float64) float64 {
+	return math.Exp(NormalQuantile(p, mu, sigma))
+}
@@ -0,0 +1,41 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLognormal(t *testing.T) {
+	cases := []struct {
+		name      string
+		mu, sigma float64
+	}{
+		{name: "mu=0,sigma=1", mu: 0.0, sigma: 1.0},
+		{name: "mu=2,sigma=0.25", mu: 2.0, sigma: 0.25},
+		{name: "mu=-1,sigma=2", mu: -1.0, sigma: 2.0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Lognormal(tc.mu, tc.sigma) }
+			cdf := func(x float64) float64 { return LognormalCDF(x, tc.mu, tc.sigma) }
+			pdf := func(x float64) float64 { return LognormalPDF(x, tc.mu, tc.sigma) }
+			quantile := func(p float64) float64 { return LognormalQuantile(p, tc.mu, tc.sigma) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
+
+func TestLognormalQuantileTail(t *testing.T) {
+	for _, p := range []float64{1e-18, 1e-100} {
+		x := LognormalQuantile(p, 0, 1)
+		if !(x > 0) {
+			t.Fatalf("expected a positive quantile for p=%e, instead got %e", p, x)
+		}
+		if v := LognormalCDF(x, 0, 1); math.Abs(v-p) > 1e-12*p {
+			t.Errorf("expected value is %e, instead got %e", p, v)
+		}
+	}
+}
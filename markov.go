@@ -0,0 +1,114 @@
+package randomvariate
+
+// MarkovChain is a discrete-time Markov chain over the states 0 to K-1.
+// The initial distribution and each row of the transition matrix are stored
+// as alias tables so that every step of a path is drawn in constant time.
+type MarkovChain struct {
+	initial *aliasTable
+	rows    []*aliasTable
+}
+
+// NewMarkovChain creates a discrete-time Markov chain from the initial
+// state distribution p0 and the K x K transition matrix P, where P[i][j] is
+// the probability of moving from state i to state j. Each row of P must sum
+// to 1.
+func NewMarkovChain(p0 []float64, P [][]float64) *MarkovChain {
+	rows := make([]*aliasTable, len(P))
+	for i, row := range P {
+		rows[i] = newAliasTable(row)
+	}
+	return &MarkovChain{
+		initial: newAliasTable(p0),
+		rows:    rows,
+	}
+}
+
+// Initial draws a starting state from the initial distribution.
+func (mc *MarkovChain) Initial() int {
+	return mc.initial.draw()
+}
+
+// Step draws the state following state i.
+func (mc *MarkovChain) Step(i int) int {
+	return mc.rows[i].draw()
+}
+
+// Path samples a trajectory of T states, starting from a state drawn from
+// the initial distribution.
+func (mc *MarkovChain) Path(T int) []int {
+	path := make([]int, T)
+	if T == 0 {
+		return path
+	}
+	path[0] = mc.Initial()
+	for t := 1; t < T; t++ {
+		path[t] = mc.Step(path[t-1])
+	}
+	return path
+}
+
+// ContinuousMarkovChain is a continuous-time Markov chain over the states
+// 0 to K-1. The chain is simulated as a jump chain, where the time spent in
+// each state is exponentially distributed.
+type ContinuousMarkovChain struct {
+	initial *aliasTable
+	rates   []float64
+	jumps   []*aliasTable
+}
+
+// NewContinuousMarkovChain creates a continuous-time Markov chain from the
+// initial state distribution p0 and the K x K rate matrix Q. Off-diagonal
+// entries Q[i][j] are the rates of moving from state i to state j, and
+// diagonal entries are ignored and taken to be the negative sum of the
+// off-diagonal entries of the row. A state whose off-diagonal rates are all
+// zero is absorbing.
+func NewContinuousMarkovChain(p0 []float64, Q [][]float64) *ContinuousMarkovChain {
+	rates := make([]float64, len(Q))
+	jumps := make([]*aliasTable, len(Q))
+	for i, row := range Q {
+		// Exit rate of state i
+		for j, q := range row {
+			if i != j {
+				rates[i] += q
+			}
+		}
+		if rates[i] <= 0 {
+			continue
+		}
+		// Jump probabilities from state i
+		p := make([]float64, len(row))
+		for j, q := range row {
+			if i != j {
+				p[j] = q / rates[i]
+			}
+		}
+		jumps[i] = newAliasTable(p)
+	}
+	return &ContinuousMarkovChain{
+		initial: newAliasTable(p0),
+		rates:   rates,
+		jumps:   jumps,
+	}
+}
+
+// Path simulates the chain over the time interval [0, end). Returns the
+// sequence of visited states and the times at which each state was
+// entered. The first state is entered at time 0.
+func (mc *ContinuousMarkovChain) Path(end float64) ([]int, []float64) {
+	state := mc.initial.draw()
+	states := []int{state}
+	times := []float64{0}
+
+	t := 0.0
+	for mc.rates[state] > 0 {
+		// Holding time in the current state
+		t += Exponential(mc.rates[state])
+		if t >= end {
+			break
+		}
+		state = mc.jumps[state].draw()
+		states = append(states, state)
+		times = append(times, t)
+	}
+	return states, times
+}
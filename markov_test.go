@@ -0,0 +1,125 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMarkovChain(t *testing.T) {
+	cases := []struct {
+		name       string
+		p0         []float64
+		P          [][]float64
+		stationary []float64
+	}{
+		{name: "states=2,dist=symmetric",
+			p0:         []float64{1.0, 0.0},
+			P:          [][]float64{{0.5, 0.5}, {0.5, 0.5}},
+			stationary: []float64{0.5, 0.5},
+		},
+		{name: "states=2,dist=skew",
+			p0:         []float64{0.5, 0.5},
+			P:          [][]float64{{0.9, 0.1}, {0.3, 0.7}},
+			stationary: []float64{0.75, 0.25},
+		},
+		{name: "states=3,dist=cycle",
+			p0:         []float64{0.0, 0.0, 1.0},
+			P:          [][]float64{{0.0, 1.0, 0.0}, {0.0, 0.0, 1.0}, {1.0, 0.0, 0.0}},
+			stationary: []float64{1.0 / 3, 1.0 / 3, 1.0 / 3},
+		},
+	}
+	iterations := 100
+	T := 1000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc := NewMarkovChain(tc.p0, tc.P)
+			// Simulate
+			cnt := make([]int, len(tc.p0))
+			for i := 0; i < iterations; i++ {
+				path := mc.Path(T)
+				if len(path) != T {
+					t.Fatalf("expected path length %d, instead got %d", T, len(path))
+				}
+				if tc.p0[path[0]] == 0 {
+					t.Fatalf("initial state %d has zero probability", path[0])
+				}
+				for j, s := range path {
+					if j > 0 && tc.P[path[j-1]][s] == 0 {
+						t.Fatalf("transition %d -> %d has zero probability", path[j-1], s)
+					}
+					cnt[s]++
+				}
+			}
+			// Check occupancy against the stationary distribution
+			for i, v := range cnt {
+				freq := float64(v) / float64(iterations*T)
+				expected := tc.stationary[i]
+				if expected+errSize < freq || expected-errSize > freq {
+					t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+				}
+			}
+		})
+	}
+}
+
+func TestContinuousMarkovChain(t *testing.T) {
+	cases := []struct {
+		name       string
+		p0         []float64
+		Q          [][]float64
+		stationary []float64
+	}{
+		{name: "states=2,rate=symmetric",
+			p0:         []float64{1.0, 0.0},
+			Q:          [][]float64{{-1.0, 1.0}, {1.0, -1.0}},
+			stationary: []float64{0.5, 0.5},
+		},
+		{name: "states=2,rate=skew",
+			p0:         []float64{0.5, 0.5},
+			Q:          [][]float64{{-1.0, 1.0}, {3.0, -3.0}},
+			stationary: []float64{0.75, 0.25},
+		},
+		{name: "states=3,rate=absorbing",
+			p0:         []float64{1.0, 0.0, 0.0},
+			Q:          [][]float64{{-2.0, 1.0, 1.0}, {1.0, -2.0, 1.0}, {0.0, 0.0, 0.0}},
+			stationary: []float64{0.0, 0.0, 1.0},
+		},
+	}
+	iterations := 100
+	end := 1000.0
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc := NewContinuousMarkovChain(tc.p0, tc.Q)
+			// Simulate and accumulate time spent in each state
+			occupancy := make([]float64, len(tc.p0))
+			for i := 0; i < iterations; i++ {
+				states, times := mc.Path(end)
+				if len(states) != len(times) {
+					t.Fatalf("number of states (%d) and times (%d) differ", len(states), len(times))
+				}
+				for j, s := range states {
+					if j > 0 && s == states[j-1] {
+						t.Fatalf("jump chain stayed in state %d", s)
+					}
+					next := end
+					if j+1 < len(times) {
+						next = times[j+1]
+					}
+					occupancy[s] += next - times[j]
+				}
+			}
+			// Check occupancy against the stationary distribution
+			for i, v := range occupancy {
+				freq := v / (float64(iterations) * end)
+				expected := tc.stationary[i]
+				if expected+errSize < freq || expected-errSize > freq {
+					t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+				}
+			}
+		})
+	}
+}
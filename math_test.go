@@ -1,6 +1,9 @@
 package randomvariate
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestRound(t *testing.T) {
 	cases := []struct {
@@ -207,3 +210,129 @@ func TestRound(t *testing.T) {
 		})
 	}
 }
+
+func TestLogBesselI(t *testing.T) {
+	cases := []struct {
+		name     string
+		n        int
+		x        float64
+		expected float64
+		epsilon  float64
+	}{
+		{name: "n=0,x=0,expected=0",
+			n:        0,
+			x:        0.0,
+			expected: 0.0,
+			epsilon:  1e-12,
+		},
+		{name: "n=0,x=1,expected=log(1.2660658777520082)",
+			n:        0,
+			x:        1.0,
+			expected: math.Log(1.2660658777520082),
+			epsilon:  1e-12,
+		},
+		{name: "n=1,x=1,expected=log(0.5651591039924851)",
+			n:        1,
+			x:        1.0,
+			expected: math.Log(0.5651591039924851),
+			epsilon:  1e-12,
+		},
+		{name: "n=-1,x=1,expected=log(0.5651591039924851)",
+			n:        -1,
+			x:        1.0,
+			expected: math.Log(0.5651591039924851),
+			epsilon:  1e-12,
+		},
+		{name: "n=0,x=10,expected=log(2815.716628466254)",
+			n:        0,
+			x:        10.0,
+			expected: math.Log(2815.716628466254),
+			epsilon:  1e-12,
+		},
+		{name: "n=5,x=2,expected=log(0.009825679323131702)",
+			n:        5,
+			x:        2.0,
+			expected: math.Log(0.009825679323131702),
+			epsilon:  1e-12,
+		},
+		{name: "n=0,x=1000,expected=995.627308889869",
+			n:        0,
+			x:        1000.0,
+			expected: 995.627308889869,
+			epsilon:  1e-9,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := LogBesselI(tc.n, tc.x)
+			if v > tc.expected+tc.epsilon || v < tc.expected-tc.epsilon {
+				t.Errorf("expected value is %e, instead got %e", tc.expected, v)
+			}
+		})
+	}
+	if v := LogBesselI(1, 0); !math.IsInf(v, -1) {
+		t.Errorf("expected negative infinity, instead got %e", v)
+	}
+}
+
+func TestRegularizedGammaP(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, x     float64
+		expected float64
+		epsilon  float64
+	}{
+		{name: "a=1,x=0.5", a: 1.0, x: 0.5, expected: 1 - math.Exp(-0.5)},
+		{name: "a=1,x=10", a: 1.0, x: 10.0, expected: 1 - math.Exp(-10)},
+		{name: "a=0.5,x=2", a: 0.5, x: 2.0, expected: math.Erf(math.Sqrt(2))},
+		{name: "a=2,x=3", a: 2.0, x: 3.0, expected: 1 - 4*math.Exp(-3)},
+		{name: "a=0.5,x=1.920729410347062", a: 0.5, x: 1.920729410347062, expected: 0.95},
+		{name: "a=5,x=0", a: 5.0, x: 0.0, expected: 0.0},
+		// Reference values for large a are P(Poisson(x) >= a) summed in
+		// 60-digit arithmetic. The series and continued fraction both need
+		// thousands of terms here.
+		{name: "a=1e5,x=99500", a: 1e5, x: 99500, expected: 0.05674182321279226, epsilon: 1e-9},
+		{name: "a=1e5,x=1e5", a: 1e5, x: 1e5, expected: 0.50042052211036514, epsilon: 1e-9},
+		{name: "a=1e5,x=100800", a: 1e5, x: 100800, expected: 0.99420125396834624, epsilon: 1e-9},
+		{name: "a=1e6,x=999000", a: 1e6, x: 999000, expected: 0.15865521357430365, epsilon: 1e-9},
+		{name: "a=1e6,x=1e6", a: 1e6, x: 1e6, expected: 0.50013298076087254, epsilon: 1e-9},
+		{name: "a=1e6,x=1002000", a: 1e6, x: 1002000, expected: 0.97719590410123014, epsilon: 1e-9},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			epsilon := tc.epsilon
+			if epsilon == 0 {
+				epsilon = 1e-12
+			}
+			v := RegularizedGammaP(tc.a, tc.x)
+			if v > tc.expected+epsilon || v < tc.expected-epsilon {
+				t.Errorf("expected value is %e, instead got %e", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestRegularizedIncompleteBeta(t *testing.T) {
+	cases := []struct {
+		name     string
+		x, a, b  float64
+		expected float64
+	}{
+		{name: "x=0.3,a=1,b=1", x: 0.3, a: 1.0, b: 1.0, expected: 0.3},
+		{name: "x=0.3,a=3,b=1", x: 0.3, a: 3.0, b: 1.0, expected: 0.027},
+		{name: "x=0.3,a=1,b=3", x: 0.3, a: 1.0, b: 3.0, expected: 1 - 0.343},
+		{name: "x=0.5,a=4,b=4", x: 0.5, a: 4.0, b: 4.0, expected: 0.5},
+		{name: "x=0.9,a=2,b=2", x: 0.9, a: 2.0, b: 2.0, expected: 3*0.81 - 2*0.729},
+		{name: "x=0,a=2,b=2", x: 0.0, a: 2.0, b: 2.0, expected: 0.0},
+		{name: "x=1,a=2,b=2", x: 1.0, a: 2.0, b: 2.0, expected: 1.0},
+	}
+	epsilon := 1e-12
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := RegularizedIncompleteBeta(tc.x, tc.a, tc.b)
+			if v > tc.expected+epsilon || v < tc.expected-epsilon {
+				t.Errorf("expected value is %e, instead got %e", tc.expected, v)
+			}
+		})
+	}
+}
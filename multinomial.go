@@ -6,78 +6,94 @@ import (
 )
 
 // Multinomial draws n samples from a probability distribution given by the
-// set of probabilities p. Uses the inversion method which may be inefficient
-// when the number of categories and number of samples are both large.
+// set of probabilities p. Uses the inversion method, where each draw is
+// located in the cumulative distribution of p with the help of a Chen-Asau
+// guide table. After the O(K) setup for K categories, each draw takes a
+// constant number of comparisons on average.
 func Multinomial(n int, p []float64) []int {
 	result := make([]int, len(p))
-	cumP := make([]float64, len(p))
-	lastIdx := len(p) - 1
+	table := newGuideTable(p)
+	for i := 0; i < n; i++ {
+		result[table.draw()]++
+	}
+	return result
+}
+
+// guideTable holds the cumulative distribution of a set of probabilities
+// together with a Chen-Asau guide table. The guide table stores, for each
+// of K equally-sized intervals of [0, 1), the first category whose
+// cumulative probability falls in that interval so that the search for a
+// draw starts close to its final position.
+type guideTable struct {
+	cumP  []float64
+	guide []int
+}
+
+// newGuideTable builds the cumulative distribution and guide table of the
+// probabilities p.
+func newGuideTable(p []float64) *guideTable {
+	g := &guideTable{}
+	g.reset(p)
+	return g
+}
+
+// reset rebuilds the cumulative distribution and guide table for the
+// probabilities p, reusing the existing buffers when they are large enough.
+func (g *guideTable) reset(p []float64) {
+	if cap(g.cumP) < len(p) {
+		g.cumP = make([]float64, len(p))
+		g.guide = make([]int, len(p))
+	}
+	cumP := g.cumP[:len(p)]
+	guide := g.guide[:len(p)]
 
 	// Create a cummulative distribution of p
 	cumP[0] = p[0]
 	for i := 1; i < len(p); i++ {
 		cumP[i] = cumP[i-1] + p[i]
 	}
-	for i := 0; i < n; i++ {
-		// Generate pseudorandom number
-		x := rand.Float64()
-		for j := 0; j < len(cumP); j++ {
-			if x < cumP[j] {
-				result[j]++
-				break
-			} else if x > cumP[lastIdx] && x <= 1.0 {
-				result[lastIdx]++
-				break
-			}
+	// Normalize so that rounding errors do not leave a gap below 1
+	total := cumP[len(cumP)-1]
+	for i := range cumP {
+		cumP[i] /= total
+	}
+
+	m := len(p)
+	j := 0
+	for i := range guide {
+		for j < len(cumP)-1 && cumP[j] <= float64(i)/float64(m) {
+			j++
 		}
+		guide[i] = j
 	}
-	return result
+	g.cumP, g.guide = cumP, guide
+}
+
+// draw samples a category index using the guide table.
+func (g *guideTable) draw() int {
+	return g.search(rand.Float64())
+}
+
+// search returns the category whose interval in the cumulative
+// distribution contains x, where x is in [0, 1).
+func (g *guideTable) search(x float64) int {
+	j := g.guide[int(x*float64(len(g.guide)))]
+	for j < len(g.cumP)-1 && x >= g.cumP[j] {
+		j++
+	}
+	return j
 }
 
 // MultinomialA draws n samples from a probability distribution given by the
 // set of probabilities p. Uses the alias method. Faster when dealing with
 // a larger number of categories and number of samples.
 func MultinomialA(n int, p []float64) []int {
-	// Setup uniform distribution
-	K := len(p)
-	q := make([]float64, K)
-	J := make([]int, K)
-
-	var smaller []int
-	var larger []int
-	for i, prob := range p {
-		q[i] = float64(K) * prob
-		if q[i] < 1.0 {
-			smaller = append(smaller, i)
-		} else {
-			larger = append(larger, i)
-		}
-	}
-
-	var small, large int
-	for len(smaller) > 0 && len(larger) > 0 {
-		small, smaller = smaller[len(smaller)-1], smaller[:len(smaller)-1]
-		large, larger = larger[len(larger)-1], larger[:len(larger)-1]
-
-		J[small] = large
-		q[large] = float64(q[large] - (1.0 - q[small]))
-
-		if q[large] < 1.0 {
-			smaller = append(smaller, large)
-		} else {
-			larger = append(larger, large)
-		}
-	}
+	table := newAliasTable(p)
 
 	// Draw sample
 	result := make([]int, len(p))
 	for i := 0; i < n; i++ {
-		kk := rand.Intn(K)
-		if rand.Float64() < float64(q[kk]) {
-			result[kk]++
-		} else {
-			result[J[kk]]++
-		}
+		result[table.draw()]++
 	}
 	return result
 }
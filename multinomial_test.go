@@ -548,34 +548,43 @@ func TestMultinomialLog(t *testing.T) {
 	}
 }
 
-// func BenchmarkMultinomial(b *testing.B) {
-// 	// 1000 sites
-// 	p := make([]float64, 10000)
-// 	var total float64
-// 	for i := 0; i < 10000; i++ {
-// 		p[i] = rand.Float64()
-// 		total += p[i]
-// 	}
-// 	for i := range p {
-// 		p[i] = p[i] / total
-// 	}
-// 	for n := 0; n < b.N; n++ {
-// 		Multinomial(1, p)
-// 	}
-// }
+func benchmarkProbabilities(k int, skewed bool) []float64 {
+	r := rand.New(rand.NewSource(0))
+	p := make([]float64, k)
+	var total float64
+	for i := range p {
+		p[i] = r.Float64()
+		if skewed {
+			p[i] = math.Pow(p[i], 20)
+		}
+		total += p[i]
+	}
+	for i := range p {
+		p[i] = p[i] / total
+	}
+	return p
+}
+
+func BenchmarkMultinomial(b *testing.B) {
+	// 10000 sites
+	p := benchmarkProbabilities(10000, false)
+	for n := 0; n < b.N; n++ {
+		Multinomial(1000, p)
+	}
+}
 
-// func BenchmarkMultinomialI(b *testing.B) {
-// 	// 1000 sites
-// 	p := make([]float64, 10000)
-// 	var total float64
-// 	for i := 0; i < 10000; i++ {
-// 		p[i] = rand.Float64()
-// 		total += p[i]
-// 	}
-// 	for i := range p {
-// 		p[i] = p[i] / total
-// 	}
-// 	for n := 0; n < b.N; n++ {
-// 		MultinomialI(1, p)
-// 	}
-// }
+func BenchmarkMultinomialA(b *testing.B) {
+	// 10000 sites
+	p := benchmarkProbabilities(10000, false)
+	for n := 0; n < b.N; n++ {
+		MultinomialA(1000, p)
+	}
+}
+
+func BenchmarkMultinomialSkewed(b *testing.B) {
+	// 10000 sites with most of the mass in a few sites
+	p := benchmarkProbabilities(10000, true)
+	for n := 0; n < b.N; n++ {
+		Multinomial(1000, p)
+	}
+}
@@ -0,0 +1,108 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// MultinomialRows draws a multinomial sample for every row of the
+// probability matrix P, where n[i] samples are drawn from the probabilities
+// in row P[i]. The search tables are built in a single scratch buffer that
+// is reused across rows, and the results share one backing array.
+func MultinomialRows(n []int, P [][]float64) [][]int {
+	var size int
+	for _, row := range P {
+		size += len(row)
+	}
+	buf := make([]int, size)
+	result := make([][]int, len(P))
+	table := &guideTable{}
+	for i, row := range P {
+		result[i], buf = buf[:len(row):len(row)], buf[len(row):]
+		if len(row) == 0 {
+			continue
+		}
+		table.reset(row)
+		for j := 0; j < n[i]; j++ {
+			result[i][table.draw()]++
+		}
+	}
+	return result
+}
+
+// MultinomialRowsFlat is like MultinomialRows but the probability matrix P
+// is given as a flat row-major slice with k categories per row. Returns
+// the counts in the same row-major layout.
+func MultinomialRowsFlat(n []int, P []float64, k int) []int {
+	result := make([]int, len(P))
+	if k == 0 {
+		return result
+	}
+	table := &guideTable{}
+	for i := range n {
+		table.reset(P[i*k : (i+1)*k])
+		row := result[i*k : (i+1)*k]
+		for j := 0; j < n[i]; j++ {
+			row[table.draw()]++
+		}
+	}
+	return result
+}
+
+// MultinomialRowsParallel is like MultinomialRows but distributes the rows
+// across the given number of goroutines. Each row is drawn from its own
+// random source seeded from the math/rand global source before the rows
+// are distributed, so the result only depends on the global seed and not
+// on the number of workers or how the rows are scheduled.
+func MultinomialRowsParallel(n []int, P [][]float64, workers int) [][]int {
+	if workers < 1 {
+		workers = 1
+	}
+	seeds := make([]int64, len(P))
+	for i := range seeds {
+		seeds[i] = rand.Int63()
+	}
+	return multinomialRowsSeeded(n, P, seeds, workers)
+}
+
+// multinomialRowsSeeded draws the multinomial sample of row i of P from a
+// random source seeded with seeds[i], distributing the rows across the
+// given number of goroutines.
+func multinomialRowsSeeded(n []int, P [][]float64, seeds []int64, workers int) [][]int {
+	var size int
+	for _, row := range P {
+		size += len(row)
+	}
+	buf := make([]int, size)
+	result := make([][]int, len(P))
+	for i, row := range P {
+		result[i], buf = buf[:len(row):len(row)], buf[len(row):]
+	}
+
+	rows := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each worker has its own scratch buffer
+			table := &guideTable{}
+			for i := range rows {
+				if len(P[i]) == 0 {
+					continue
+				}
+				r := rand.New(rand.NewSource(seeds[i]))
+				table.reset(P[i])
+				for j := 0; j < n[i]; j++ {
+					result[i][table.search(r.Float64())]++
+				}
+			}
+		}()
+	}
+	for i := range P {
+		rows <- i
+	}
+	close(rows)
+	wg.Wait()
+	return result
+}
@@ -0,0 +1,129 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+var multinomialRowsCases = []struct {
+	name string
+	n    []int
+	P    [][]float64
+}{
+	{name: "rows=1,plen=2",
+		n: []int{1},
+		P: [][]float64{{0.5, 0.5}},
+	},
+	{name: "rows=3,plen=3",
+		n: []int{1, 2, 5},
+		P: [][]float64{
+			{0.6, 0.3, 0.1},
+			{0.1, 0.3, 0.6},
+			{0.0, 1.0, 0.0},
+		},
+	},
+	{name: "rows=4,plen=4,n=zero",
+		n: []int{0, 3, 0, 3},
+		P: [][]float64{
+			{0.25, 0.25, 0.25, 0.25},
+			{0.7, 0.1, 0.1, 0.1},
+			{0.25, 0.25, 0.25, 0.25},
+			{0.0, 0.0, 0.5, 0.5},
+		},
+	},
+}
+
+func checkMultinomialRows(t *testing.T, n []int, P [][]float64, results [][][]int) {
+	errSize := 0.05
+	for i, row := range P {
+		sum := make([]int, len(row))
+		for _, res := range results {
+			var total int
+			for c, v := range res[i] {
+				sum[c] += v
+				total += v
+			}
+			if total != n[i] {
+				t.Fatalf("expected %d draws in row %d, instead got %d", n[i], i, total)
+			}
+		}
+		if n[i] == 0 {
+			continue
+		}
+		for c, v := range sum {
+			freq := float64(v) / float64(len(results)*n[i])
+			expected := row[c]
+			if expected+errSize < freq || expected-errSize > freq {
+				t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+			}
+		}
+	}
+}
+
+func TestMultinomialRows(t *testing.T) {
+	iterations := 1000
+	rand.Seed(0)
+	for _, tc := range multinomialRowsCases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := make([][][]int, iterations)
+			for i := range results {
+				results[i] = MultinomialRows(tc.n, tc.P)
+			}
+			checkMultinomialRows(t, tc.n, tc.P, results)
+		})
+	}
+}
+
+func TestMultinomialRowsFlat(t *testing.T) {
+	iterations := 1000
+	rand.Seed(0)
+	for _, tc := range multinomialRowsCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k := len(tc.P[0])
+			var flat []float64
+			for _, row := range tc.P {
+				flat = append(flat, row...)
+			}
+			results := make([][][]int, iterations)
+			for i := range results {
+				counts := MultinomialRowsFlat(tc.n, flat, k)
+				for r := range tc.P {
+					results[i] = append(results[i], counts[r*k:(r+1)*k])
+				}
+			}
+			checkMultinomialRows(t, tc.n, tc.P, results)
+		})
+	}
+}
+
+func TestMultinomialRowsParallel(t *testing.T) {
+	iterations := 1000
+	rand.Seed(0)
+	for _, tc := range multinomialRowsCases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := make([][][]int, iterations)
+			for i := range results {
+				results[i] = MultinomialRowsParallel(tc.n, tc.P, 4)
+			}
+			checkMultinomialRows(t, tc.n, tc.P, results)
+		})
+	}
+
+	// Results do not depend on the number of workers
+	n := make([]int, 50)
+	P := make([][]float64, 50)
+	for i := range P {
+		n[i] = 100
+		P[i] = []float64{0.1, 0.2, 0.3, 0.4}
+	}
+	seeds := make([]int64, len(P))
+	for i := range seeds {
+		seeds[i] = int64(i)
+	}
+	expected := multinomialRowsSeeded(n, P, seeds, 1)
+	result := multinomialRowsSeeded(n, P, seeds, 8)
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("results differ between 1 and 8 workers")
+	}
+}
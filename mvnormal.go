@@ -0,0 +1,103 @@
+package randomvariate
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// MVNormal is a multivariate normal distribution. The covariance matrix is
+// factored once when the distribution is created so that samples can be
+// drawn repeatedly at the cost of a matrix-vector product.
+// An MVNormal keeps an internal scratch buffer and is not safe for
+// concurrent use; create one per goroutine instead.
+type MVNormal struct {
+	mu     []float64
+	factor [][]float64
+	z      []float64
+}
+
+// NewMVNormal creates a multivariate normal distribution with mean vector
+// mu and covariance matrix cov. The covariance matrix is factored by
+// Cholesky decomposition. If cov is only positive semi-definite, the
+// factor is computed from its eigendecomposition instead, with eigenvalues
+// within rounding error of zero clamped to zero. Returns an error if cov is
+// not square, does not match the length of mu, or has a clearly negative
+// eigenvalue.
+func NewMVNormal(mu []float64, cov [][]float64) (*MVNormal, error) {
+	n := len(mu)
+	if len(cov) != n {
+		return nil, errors.New("covariance matrix does not match the length of the mean vector")
+	}
+	for _, row := range cov {
+		if len(row) != n {
+			return nil, errors.New("covariance matrix is not square")
+		}
+	}
+
+	factor, ok := cholesky(cov)
+	if !ok {
+		var err error
+		factor, err = eigenFactor(cov)
+		if err != nil {
+			return nil, err
+		}
+	}
+	m := &MVNormal{
+		mu:     make([]float64, n),
+		factor: factor,
+		z:      make([]float64, n),
+	}
+	copy(m.mu, mu)
+	return m, nil
+}
+
+// eigenFactor computes a factor A of the symmetric positive semi-definite
+// matrix cov such that cov = A A^T, from its eigendecomposition.
+func eigenFactor(cov [][]float64) ([][]float64, error) {
+	values, vectors := symmetricEigen(cov)
+	var maxValue float64
+	for _, v := range values {
+		maxValue = math.Max(maxValue, math.Abs(v))
+	}
+	tol := 1e-10 * math.Max(maxValue, 1)
+	for i, v := range values {
+		if v < -tol {
+			return nil, errors.New("covariance matrix is not positive semi-definite")
+		}
+		values[i] = math.Sqrt(math.Max(v, 0))
+	}
+	// Scale each eigenvector by the square root of its eigenvalue
+	for _, row := range vectors {
+		for j := range row {
+			row[j] *= values[j]
+		}
+	}
+	return vectors, nil
+}
+
+// Dim returns the number of dimensions of the distribution.
+func (m *MVNormal) Dim() int {
+	return len(m.mu)
+}
+
+// Rand draws a sample into dst and returns it. If dst is too short to hold
+// a sample, a new slice is allocated.
+func (m *MVNormal) Rand(dst []float64) []float64 {
+	n := len(m.mu)
+	if len(dst) < n {
+		dst = make([]float64, n)
+	}
+	dst = dst[:n]
+	for i := range m.z {
+		m.z[i] = rand.NormFloat64()
+	}
+	for i, row := range m.factor {
+		x := m.mu[i]
+		for j, a := range row {
+			x += a * m.z[j]
+		}
+		dst[i] = x
+	}
+	return dst
+}
@@ -0,0 +1,114 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMVNormal(t *testing.T) {
+	cases := []struct {
+		name string
+		mu   []float64
+		cov  [][]float64
+	}{
+		{name: "dim=1",
+			mu:  []float64{3},
+			cov: [][]float64{{4}},
+		},
+		{name: "dim=2,cov=correlated",
+			mu:  []float64{0, 1},
+			cov: [][]float64{{1, 0.8}, {0.8, 1}},
+		},
+		{name: "dim=3,cov=general",
+			mu:  []float64{-1, 0, 2},
+			cov: [][]float64{{4, 2, 0.6}, {2, 5, 1.5}, {0.6, 1.5, 3}},
+		},
+		{name: "dim=3,cov=semidefinite",
+			mu:  []float64{0, 0, 0},
+			cov: [][]float64{{1, 1, 0}, {1, 1, 0}, {0, 0, 2}},
+		},
+		{name: "dim=2,cov=zero",
+			mu:  []float64{5, -5},
+			cov: [][]float64{{0, 0}, {0, 0}},
+		},
+	}
+	iterations := 50000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMVNormal(tc.mu, tc.cov)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if m.Dim() != len(tc.mu) {
+				t.Fatalf("expected dimension %d, instead got %d", len(tc.mu), m.Dim())
+			}
+			// Simulate
+			n := len(tc.mu)
+			sum := make([]float64, n)
+			sumSq := make([][]float64, n)
+			for i := range sumSq {
+				sumSq[i] = make([]float64, n)
+			}
+			buf := make([]float64, n)
+			for i := 0; i < iterations; i++ {
+				x := m.Rand(buf)
+				if &x[0] != &buf[0] {
+					t.Fatalf("sample was not written into the given buffer")
+				}
+				for j := range x {
+					sum[j] += x[j]
+					for k := range x {
+						sumSq[j][k] += x[j] * x[k]
+					}
+				}
+			}
+			// Check mean and covariance
+			for j := range sum {
+				mean := sum[j] / float64(iterations)
+				if tc.mu[j]+errSize < mean || tc.mu[j]-errSize > mean {
+					t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, tc.mu[j], errSize)
+				}
+			}
+			for j := range sumSq {
+				for k := range sumSq[j] {
+					cov := sumSq[j][k]/float64(iterations) - sum[j]*sum[k]/float64(iterations*iterations)
+					expected := tc.cov[j][k]
+					err := errSize * (1 + expected)
+					if expected+err < cov || expected-err > cov {
+						t.Errorf("covariance (%f) is greater than expected (%f) +/- (%f)", cov, expected, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewMVNormalError(t *testing.T) {
+	cases := []struct {
+		name string
+		mu   []float64
+		cov  [][]float64
+	}{
+		{name: "cov=indefinite",
+			mu:  []float64{0, 0},
+			cov: [][]float64{{1, 2}, {2, 1}},
+		},
+		{name: "cov=wrong_size",
+			mu:  []float64{0, 0, 0},
+			cov: [][]float64{{1, 0}, {0, 1}},
+		},
+		{name: "cov=not_square",
+			mu:  []float64{0, 0},
+			cov: [][]float64{{1, 0}, {0}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewMVNormal(tc.mu, tc.cov); err == nil {
+				t.Errorf("expected an error, instead got nil")
+			}
+		})
+	}
+}
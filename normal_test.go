@@ -0,0 +1,70 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormal(t *testing.T) {
+	cases := []struct {
+		name      string
+		mu, sigma float64
+	}{
+		{name: "mu=0,sigma=1", mu: 0.0, sigma: 1.0},
+		{name: "mu=5,sigma=0.1", mu: 5.0, sigma: 0.1},
+		{name: "mu=-3,sigma=10", mu: -3.0, sigma: 10.0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Normal(tc.mu, tc.sigma) }
+			cdf := func(x float64) float64 { return NormalCDF(x, tc.mu, tc.sigma) }
+			pdf := func(x float64) float64 { return NormalPDF(x, tc.mu, tc.sigma) }
+			var points []float64
+			for _, z := range []float64{-2, -1, 0, 0.5, 1.5} {
+				points = append(points, tc.mu+z*tc.sigma)
+			}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.mu-3*tc.sigma, tc.mu+2*tc.sigma)
+		})
+	}
+}
+
+func TestNormalQuantile(t *testing.T) {
+	cases := []struct {
+		name     string
+		p        float64
+		expected float64
+	}{
+		{name: "p=0.5", p: 0.5, expected: 0.0},
+		{name: "p=0.975", p: 0.975, expected: 1.959963984540054},
+		{name: "p=0.025", p: 0.025, expected: -1.959963984540054},
+		{name: "p=1e-10", p: 1e-10, expected: -6.361340902404056},
+		{name: "p=0", p: 0.0, expected: math.Inf(-1)},
+		{name: "p=1", p: 1.0, expected: math.Inf(1)},
+	}
+	epsilon := 1e-9
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := NormalQuantile(tc.p, 0, 1)
+			if math.IsInf(tc.expected, 0) {
+				if v != tc.expected {
+					t.Errorf("expected value is %e, instead got %e", tc.expected, v)
+				}
+				return
+			}
+			if v > tc.expected+epsilon || v < tc.expected-epsilon {
+				t.Errorf("expected value is %e, instead got %e", tc.expected, v)
+			}
+		})
+	}
+	// Quantile inverts the CDF
+	for _, p := range []float64{1e-300, 1e-100, 1e-20, 1e-17, 1e-8, 0.01, 0.3, 0.5, 0.7, 0.99} {
+		x := NormalQuantile(p, 2, 3)
+		if math.IsInf(x, 0) {
+			t.Errorf("expected a finite quantile for p=%e, instead got %e", p, x)
+		}
+		if v := NormalCDF(x, 2, 3); math.Abs(v-p) > 1e-12*p {
+			t.Errorf("expected value is %e, instead got %e", p, v)
+		}
+	}
+}
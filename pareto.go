@@ -0,0 +1,63 @@
+package randomvariate
+
+import "math"
+
+// Pareto draws a sample from a Pareto type I distribution with minimum
+// value xm and shape alpha by inversion.
+func Pareto(xm, alpha float64) float64 {
+	return ParetoQuantile(openUniform(), xm, alpha)
+}
+
+// ParetoPDF returns the probability density of a Pareto type I
+// distribution at x.
+func ParetoPDF(x, xm, alpha float64) float64 {
+	if x < xm {
+		return 0
+	}
+	return alpha * math.Pow(xm, alpha) / math.Pow(x, alpha+1)
+}
+
+// ParetoCDF returns the cumulative probability of a Pareto type I
+// distribution at x.
+func ParetoCDF(x, xm, alpha float64) float64 {
+	if x < xm {
+		return 0
+	}
+	return 1 - math.Pow(xm/x, alpha)
+}
+
+// ParetoQuantile returns the value x at which the cumulative probability
+// of a Pareto type I distribution is p.
+func ParetoQuantile(p, xm, alpha float64) float64 {
+	return xm * math.Pow(1-p, -1/alpha)
+}
+
+// Lomax draws a sample from a Pareto type II distribution, also known as
+// the Lomax distribution, with scale lambda and shape alpha by inversion.
+// The distribution is a Pareto type I distribution shifted to start at 0.
+func Lomax(lambda, alpha float64) float64 {
+	return LomaxQuantile(openUniform(), lambda, alpha)
+}
+
+// LomaxPDF returns the probability density of a Lomax distribution at x.
+func LomaxPDF(x, lambda, alpha float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return alpha / lambda * math.Pow(1+x/lambda, -(alpha+1))
+}
+
+// LomaxCDF returns the cumulative probability of a Lomax distribution at
+// x.
+func LomaxCDF(x, lambda, alpha float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return 1 - math.Pow(1+x/lambda, -alpha)
+}
+
+// LomaxQuantile returns the value x at which the cumulative probability of
+// a Lomax distribution is p.
+func LomaxQuantile(p, lambda, alpha float64) float64 {
+	return lambda * math.Expm1(-math.Log1p(-p)/alpha)
+}
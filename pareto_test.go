@@ -0,0 +1,49 @@
+package randomvariate
+
+import "testing"
+
+func TestPareto(t *testing.T) {
+	cases := []struct {
+		name      string
+		xm, alpha float64
+	}{
+		{name: "xm=1,alpha=1", xm: 1.0, alpha: 1.0},
+		{name: "xm=2,alpha=3", xm: 2.0, alpha: 3.0},
+		{name: "xm=0.1,alpha=0.5", xm: 0.1, alpha: 0.5},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Pareto(tc.xm, tc.alpha) }
+			cdf := func(x float64) float64 { return ParetoCDF(x, tc.xm, tc.alpha) }
+			pdf := func(x float64) float64 { return ParetoPDF(x, tc.xm, tc.alpha) }
+			quantile := func(p float64) float64 { return ParetoQuantile(p, tc.xm, tc.alpha) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
+
+func TestLomax(t *testing.T) {
+	cases := []struct {
+		name          string
+		lambda, alpha float64
+	}{
+		{name: "lambda=1,alpha=1", lambda: 1.0, alpha: 1.0},
+		{name: "lambda=2,alpha=3", lambda: 2.0, alpha: 3.0},
+		{name: "lambda=0.5,alpha=0.5", lambda: 0.5, alpha: 0.5},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Lomax(tc.lambda, tc.alpha) }
+			cdf := func(x float64) float64 { return LomaxCDF(x, tc.lambda, tc.alpha) }
+			pdf := func(x float64) float64 { return LomaxPDF(x, tc.lambda, tc.alpha) }
+			quantile := func(p float64) float64 { return LomaxQuantile(p, tc.lambda, tc.alpha) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// PoissonProcess returns the event times of a homogeneous Poisson point
+// process with the given rate over the interval [start, end). Waiting times
+// between events are drawn from an exponential distribution, so the returned
+// times are already sorted.
+func PoissonProcess(rate, start, end float64) []float64 {
+	var times []float64
+	if rate <= 0 {
+		return times
+	}
+	t := start
+	for {
+		t += Exponential(rate)
+		if t >= end {
+			break
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+// InhomogeneousPoissonProcess returns the event times of an inhomogeneous
+// Poisson point process over the interval [start, end) whose intensity at
+// time t is given by rate(t). Uses the Lewis-Shedler thinning algorithm,
+// where rateMax must be an upper bound of rate(t) over the whole interval.
+// The closer rateMax is to the supremum of rate, the fewer candidate events
+// are discarded.
+func InhomogeneousPoissonProcess(rate func(float64) float64, rateMax, start, end float64) []float64 {
+	var times []float64
+	for _, t := range PoissonProcess(rateMax, start, end) {
+		// Keep candidate with probability rate(t)/rateMax
+		if rand.Float64()*rateMax < rate(t) {
+			times = append(times, t)
+		}
+	}
+	return times
+}
+
+// SpatialPoissonProcess returns the locations of events of a homogeneous
+// Poisson point process over the rectangle [xmin, xmax) x [ymin, ymax).
+// The number of events is Poisson distributed with mean equal to the
+// intensity times the area of the rectangle, and each event is placed
+// uniformly within the rectangle.
+func SpatialPoissonProcess(intensity, xmin, xmax, ymin, ymax float64) [][2]float64 {
+	n := poissonCount(intensity * (xmax - xmin) * (ymax - ymin))
+	points := make([][2]float64, n)
+	for i := range points {
+		points[i] = [2]float64{Uniform(xmin, xmax), Uniform(ymin, ymax)}
+	}
+	return points
+}
+
+// SpatialPoissonProcessPolygon returns the locations of events of a
+// homogeneous Poisson point process inside a simple polygon. The polygon is
+// given as a list of vertices in order, either clockwise or
+// counter-clockwise, without repeating the first vertex at the end.
+// Points are placed by rejection sampling from the bounding box of the
+// polygon.
+func SpatialPoissonProcessPolygon(intensity float64, polygon [][2]float64) [][2]float64 {
+	n := poissonCount(intensity * polygonArea(polygon))
+	return UniformPolygon(make([][2]float64, n), polygon)
+}
+
+// polygonArea computes the area of a simple polygon using the shoelace
+// formula.
+func polygonArea(polygon [][2]float64) float64 {
+	var area float64
+	j := len(polygon) - 1
+	for i := range polygon {
+		area += (polygon[j][0] + polygon[i][0]) * (polygon[j][1] - polygon[i][1])
+		j = i
+	}
+	return math.Abs(area) / 2
+}
+
+// insidePolygon checks whether the point pt lies inside the polygon using
+// the even-odd ray casting rule.
+func insidePolygon(pt [2]float64, polygon [][2]float64) bool {
+	inside := false
+	j := len(polygon) - 1
+	for i := range polygon {
+		xi, yi := polygon[i][0], polygon[i][1]
+		xj, yj := polygon[j][0], polygon[j][1]
+		if (yi > pt[1]) != (yj > pt[1]) &&
+			pt[0] < (xj-xi)*(pt[1]-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+		j = i
+	}
+	return inside
+}
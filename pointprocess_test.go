@@ -0,0 +1,186 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPoissonProcess(t *testing.T) {
+	cases := []struct {
+		name       string
+		rate       float64
+		start, end float64
+	}{
+		{name: "rate=1,interval=[0,10)",
+			rate:  1.0,
+			start: 0.0,
+			end:   10.0,
+		},
+		{name: "rate=5,interval=[-2,2)",
+			rate:  5.0,
+			start: -2.0,
+			end:   2.0,
+		},
+		{name: "rate=0.1,interval=[100,200)",
+			rate:  0.1,
+			start: 100.0,
+			end:   200.0,
+		},
+	}
+	iterations := 10000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var sum int
+			for i := 0; i < iterations; i++ {
+				times := PoissonProcess(tc.rate, tc.start, tc.end)
+				if !sort.Float64sAreSorted(times) {
+					t.Fatalf("event times are not sorted")
+				}
+				for _, v := range times {
+					if v < tc.start || v >= tc.end {
+						t.Fatalf("event time (%f) is outside [%f, %f)", v, tc.start, tc.end)
+					}
+				}
+				sum += len(times)
+			}
+			// Check mean number of events
+			mean := float64(sum) / float64(iterations)
+			expected := tc.rate * (tc.end - tc.start)
+			err := expected * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean count (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+		})
+	}
+}
+
+func TestInhomogeneousPoissonProcess(t *testing.T) {
+	cases := []struct {
+		name     string
+		rate     func(float64) float64
+		rateMax  float64
+		end      float64
+		expected float64
+	}{
+		{name: "rate=t,interval=[0,10)",
+			rate:     func(t float64) float64 { return t },
+			rateMax:  10.0,
+			end:      10.0,
+			expected: 50.0,
+		},
+		{name: "rate=2,interval=[0,10)",
+			rate:     func(t float64) float64 { return 2.0 },
+			rateMax:  5.0,
+			end:      10.0,
+			expected: 20.0,
+		},
+		{name: "rate=step,interval=[0,10)",
+			rate: func(t float64) float64 {
+				if t < 5 {
+					return 0.0
+				}
+				return 4.0
+			},
+			rateMax:  4.0,
+			end:      10.0,
+			expected: 20.0,
+		},
+	}
+	iterations := 10000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var sum int
+			for i := 0; i < iterations; i++ {
+				times := InhomogeneousPoissonProcess(tc.rate, tc.rateMax, 0, tc.end)
+				for _, v := range times {
+					if tc.rate(v) == 0 {
+						t.Fatalf("event at time (%f) where rate is zero", v)
+					}
+				}
+				sum += len(times)
+			}
+			// Check mean number of events
+			mean := float64(sum) / float64(iterations)
+			err := tc.expected * errSize
+			if tc.expected+err <= mean || tc.expected-err >= mean {
+				t.Errorf("mean count (%f) is greater than expected (%f) +/- (%f)", mean, tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestSpatialPoissonProcess(t *testing.T) {
+	iterations := 10000
+	errSize := 0.05
+	intensity := 2.0
+	rand.Seed(0)
+
+	var sum int
+	for i := 0; i < iterations; i++ {
+		points := SpatialPoissonProcess(intensity, 0, 4, -1, 1)
+		for _, pt := range points {
+			if pt[0] < 0 || pt[0] >= 4 || pt[1] < -1 || pt[1] >= 1 {
+				t.Fatalf("point (%f, %f) is outside the rectangle", pt[0], pt[1])
+			}
+		}
+		sum += len(points)
+	}
+	mean := float64(sum) / float64(iterations)
+	expected := intensity * 8
+	err := expected * errSize
+	if expected+err <= mean || expected-err >= mean {
+		t.Errorf("mean count (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+	}
+}
+
+func TestSpatialPoissonProcessPolygon(t *testing.T) {
+	cases := []struct {
+		name    string
+		polygon [][2]float64
+		area    float64
+	}{
+		{name: "shape=triangle",
+			polygon: [][2]float64{{0, 0}, {4, 0}, {0, 4}},
+			area:    8.0,
+		},
+		{name: "shape=square",
+			polygon: [][2]float64{{0, 0}, {0, 2}, {2, 2}, {2, 0}},
+			area:    4.0,
+		},
+		{name: "shape=l_shape",
+			polygon: [][2]float64{{0, 0}, {3, 0}, {3, 1}, {1, 1}, {1, 3}, {0, 3}},
+			area:    5.0,
+		},
+	}
+	iterations := 5000
+	errSize := 0.05
+	intensity := 3.0
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sum int
+			for i := 0; i < iterations; i++ {
+				points := SpatialPoissonProcessPolygon(intensity, tc.polygon)
+				for _, pt := range points {
+					if !insidePolygon(pt, tc.polygon) {
+						t.Fatalf("point (%f, %f) is outside the polygon", pt[0], pt[1])
+					}
+				}
+				sum += len(points)
+			}
+			mean := float64(sum) / float64(iterations)
+			expected := intensity * tc.area
+			err := expected * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean count (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+		})
+	}
+}
@@ -41,3 +41,70 @@ func PoissonXL(lambda float64) int {
 		}
 	}
 }
+
+// poissonCount draws a Poisson count for any non-negative lambda, choosing
+// between Poisson and PoissonXL depending on the size of lambda.
+func poissonCount(lambda float64) int {
+	if lambda <= 0 {
+		return 0
+	} else if lambda < 30 {
+		return Poisson(lambda)
+	}
+	return PoissonXL(lambda)
+}
+
+// PoissonPMF returns the probability of observing k events from a Poisson
+// distribution with mean lambda.
+func PoissonPMF(k int, lambda float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return math.Exp(poissonLogPMF(k, lambda))
+}
+
+// poissonLogPMF returns the log-probability of observing k events from a
+// Poisson distribution with mean lambda.
+func poissonLogPMF(k int, lambda float64) float64 {
+	if lambda == 0 {
+		if k == 0 {
+			return 0
+		}
+		return math.Inf(-1)
+	}
+	logKFactorial, _ := math.Lgamma(float64(k) + 1)
+	return float64(k)*math.Log(lambda) - lambda - logKFactorial
+}
+
+// PoissonQuantile returns the smallest count k such that the cumulative
+// probability of a Poisson distribution with mean lambda at k is at least
+// p. The search starts from a normal approximation of the quantile and
+// walks the cumulative distribution from there. Because the support is
+// unbounded, p >= 1 is treated as the largest probability below 1.
+func PoissonQuantile(p, lambda float64) int {
+	if p <= 0 || lambda == 0 {
+		return 0
+	} else if p >= 1 {
+		return PoissonQuantile(math.Nextafter(1, 0), lambda)
+	}
+	k := int(math.Max(0, math.Floor(lambda+math.Sqrt(lambda)*NormalQuantile(p, 0, 1))))
+	cdf := 1 - RegularizedGammaP(float64(k)+1, lambda)
+	pmf := PoissonPMF(k, lambda)
+	if cdf >= p {
+		for k > 0 && cdf-pmf >= p {
+			cdf -= pmf
+			pmf *= float64(k) / lambda
+			k--
+		}
+		return k
+	}
+	for cdf < p {
+		k++
+		pmf *= lambda / float64(k)
+		if pmf == 0 && float64(k) > lambda {
+			// The remaining tail is below floating point precision
+			break
+		}
+		cdf += pmf
+	}
+	return k
+}
@@ -1,6 +1,7 @@
 package randomvariate
 
 import (
+	"math"
 	"math/rand"
 	"testing"
 
@@ -107,3 +108,84 @@ func TestPoissonXL(t *testing.T) {
 		})
 	}
 }
+
+func TestPoissonPMF(t *testing.T) {
+	cases := []struct {
+		name     string
+		k        int
+		lambda   float64
+		expected float64
+	}{
+		{name: "k=0,lambda=1", k: 0, lambda: 1.0, expected: math.Exp(-1)},
+		{name: "k=2,lambda=1", k: 2, lambda: 1.0, expected: math.Exp(-1) / 2},
+		{name: "k=3,lambda=2.5", k: 3, lambda: 2.5, expected: math.Pow(2.5, 3) * math.Exp(-2.5) / 6},
+		{name: "k=0,lambda=0", k: 0, lambda: 0.0, expected: 1.0},
+		{name: "k=1,lambda=0", k: 1, lambda: 0.0, expected: 0.0},
+		{name: "k=-1,lambda=1", k: -1, lambda: 1.0, expected: 0.0},
+	}
+	epsilon := 1e-12
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := PoissonPMF(tc.k, tc.lambda)
+			if v > tc.expected+epsilon || v < tc.expected-epsilon {
+				t.Errorf("expected value is %e, instead got %e", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestPoissonQuantile(t *testing.T) {
+	lambdas := []float64{0, 0.1, 1, 4.5, 30, 800}
+	ps := []float64{0, 1e-6, 0.01, 0.25, 0.5, 0.75, 0.99, 1 - 1e-9}
+	for _, lambda := range lambdas {
+		for _, p := range ps {
+			k := PoissonQuantile(p, lambda)
+			// The quantile is the smallest k whose CDF reaches p
+			var cdf float64
+			for i := 0; i < k; i++ {
+				cdf += PoissonPMF(i, lambda)
+			}
+			if k > 0 && cdf >= p {
+				t.Errorf("lambda=%v,p=%v: CDF at k-1=%d (%e) already reaches p", lambda, p, k-1, cdf)
+			}
+			cdf += PoissonPMF(k, lambda)
+			if cdf < p-1e-12 {
+				t.Errorf("lambda=%v,p=%v: CDF at k=%d (%e) is less than p", lambda, p, k, cdf)
+			}
+		}
+		// p = 1 returns a finite count beyond which the upper tail is
+		// below floating point precision
+		k := PoissonQuantile(1, lambda)
+		if float64(k) > lambda+50*math.Sqrt(lambda)+50 {
+			t.Errorf("lambda=%v,p=1: expected a finite upper quantile, instead got %d", lambda, k)
+		}
+		var cdf float64
+		for i := 0; i <= k; i++ {
+			cdf += PoissonPMF(i, lambda)
+		}
+		if cdf < 1-1e-12 {
+			t.Errorf("lambda=%v,p=1: CDF at k=%d (%e) is not close to 1", lambda, k, cdf)
+		}
+	}
+}
+
+func TestPoissonQuantileLargeRate(t *testing.T) {
+	// Summing a million PMF terms loses some precision, so the bracketing
+	// is checked to within tolerance
+	lambda := 1e6
+	tolerance := 1e-8
+	for _, p := range []float64{0.001, 0.025, 0.5, 0.975, 0.999} {
+		k := PoissonQuantile(p, lambda)
+		var cdf float64
+		for i := 0; i < k; i++ {
+			cdf += PoissonPMF(i, lambda)
+		}
+		if cdf >= p+tolerance {
+			t.Errorf("lambda=%v,p=%v: CDF at k-1=%d (%e) already reaches p", lambda, p, k-1, cdf)
+		}
+		cdf += PoissonPMF(k, lambda)
+		if cdf < p-tolerance {
+			t.Errorf("lambda=%v,p=%v: CDF at k=%d (%e) is less than p", lambda, p, k, cdf)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Shuffle randomly permutes n elements using the Fisher-Yates algorithm.
+// swap is called to exchange the elements at positions i and j.
+func Shuffle(n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		j := rand.Intn(i + 1)
+		swap(i, j)
+	}
+}
+
+// Permutation returns a random permutation of the integers from 0 to n-1.
+func Permutation(n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = i
+	}
+	Shuffle(n, func(i, j int) { result[i], result[j] = result[j], result[i] })
+	return result
+}
+
+// SampleWithoutReplacement chooses k distinct integers uniformly from 0 to
+// n-1 using Floyd's algorithm, which only draws k random numbers regardless
+// of n. Returns the chosen integers in ascending order. If k > n, all n
+// integers are returned.
+func SampleWithoutReplacement(n, k int) []int {
+	if k > n {
+		k = n
+	}
+	chosen := make(map[int]bool, k)
+	result := make([]int, 0, k)
+	for j := n - k; j < n; j++ {
+		t := rand.Intn(j + 1)
+		if chosen[t] {
+			t = j
+		}
+		chosen[t] = true
+		result = append(result, t)
+	}
+	sort.Ints(result)
+	return result
+}
+
+// Reservoir selects k items uniformly without replacement from a stream of
+// items whose length is not known in advance. Uses Li's Algorithm L, which
+// draws geometric jumps over the stream so that random numbers are only
+// generated for items that enter the reservoir.
+type Reservoir struct {
+	k       int
+	n       int
+	next    int
+	w       float64
+	indices []int
+}
+
+// NewReservoir creates an empty reservoir holding at most k items.
+func NewReservoir(k int) *Reservoir {
+	return &Reservoir{k: k, indices: make([]int, 0, k)}
+}
+
+// Add offers the next item in the stream to the reservoir. Items are
+// identified by the order in which they were added, starting from 0.
+func (r *Reservoir) Add() {
+	i := r.n
+	r.n++
+	if r.k <= 0 {
+		return
+	}
+
+	// Fill the reservoir
+	if len(r.indices) < r.k {
+		r.indices = append(r.indices, i)
+		if len(r.indices) == r.k {
+			r.w = math.Exp(math.Log(rand.Float64()) / float64(r.k))
+			r.skip(i)
+		}
+		return
+	}
+
+	if i < r.next {
+		return
+	}
+	r.indices[rand.Intn(r.k)] = i
+	r.w *= math.Exp(math.Log(rand.Float64()) / float64(r.k))
+	r.skip(i)
+}
+
+// skip draws the index of the next item that enters the reservoir after
+// item i.
+func (r *Reservoir) skip(i int) {
+	r.next = i + int(math.Floor(math.Log(rand.Float64())/math.Log1p(-r.w))) + 1
+}
+
+// Indices returns the indices of the items currently in the reservoir, in
+// no particular order.
+func (r *Reservoir) Indices() []int {
+	result := make([]int, len(r.indices))
+	copy(result, r.indices)
+	return result
+}
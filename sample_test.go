@@ -0,0 +1,155 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	iterations := 60000
+	errSize := 0.02
+	rand.Seed(0)
+
+	// Each of the 6 orderings of 3 elements should be equally likely
+	cnt := make(map[[3]int]int)
+	for i := 0; i < iterations; i++ {
+		x := [3]int{0, 1, 2}
+		Shuffle(len(x), func(i, j int) { x[i], x[j] = x[j], x[i] })
+		cnt[x]++
+	}
+	if len(cnt) != 6 {
+		t.Fatalf("expected 6 distinct orderings, instead got %d", len(cnt))
+	}
+	for k, v := range cnt {
+		freq := float64(v) / float64(iterations)
+		expected := 1.0 / 6
+		if expected+errSize < freq || expected-errSize > freq {
+			t.Errorf("frequency of %v (%f) is greater than expected (%f) +/- (%f)", k, freq, expected, errSize)
+		}
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	cases := []struct {
+		name string
+		n    int
+	}{
+		{name: "n=0", n: 0},
+		{name: "n=1", n: 1},
+		{name: "n=10", n: 10},
+	}
+	iterations := 10000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Count how often each value lands in each position
+			cnt := make([][]int, tc.n)
+			for i := range cnt {
+				cnt[i] = make([]int, tc.n)
+			}
+			for i := 0; i < iterations; i++ {
+				perm := Permutation(tc.n)
+				sorted := append([]int(nil), perm...)
+				sort.Ints(sorted)
+				for j, v := range sorted {
+					if v != j {
+						t.Fatalf("%v is not a permutation of 0 to %d", perm, tc.n-1)
+					}
+				}
+				for pos, v := range perm {
+					cnt[pos][v]++
+				}
+			}
+			for _, row := range cnt {
+				for _, v := range row {
+					freq := float64(v) / float64(iterations)
+					expected := 1.0 / float64(tc.n)
+					if expected+errSize < freq || expected-errSize > freq {
+						t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+					}
+				}
+			}
+		})
+	}
+}
+
+var uniformSampleCases = []struct {
+	name string
+	n, k int
+}{
+	{name: "n=10,k=0", n: 10, k: 0},
+	{name: "n=10,k=1", n: 10, k: 1},
+	{name: "n=10,k=3", n: 10, k: 3},
+	{name: "n=10,k=10", n: 10, k: 10},
+	{name: "n=5,k=8", n: 5, k: 8},
+	{name: "n=100,k=20", n: 100, k: 20},
+}
+
+func checkUniformSample(t *testing.T, n, k int, samples [][]int) {
+	expectedLen := k
+	if k > n {
+		expectedLen = n
+	}
+	cnt := make([]int, n)
+	for _, s := range samples {
+		if len(s) != expectedLen {
+			t.Fatalf("expected %d indices, instead got %d", expectedLen, len(s))
+		}
+		seen := make(map[int]bool)
+		for _, i := range s {
+			if i < 0 || i >= n {
+				t.Fatalf("index (%d) is outside [0, %d)", i, n)
+			}
+			if seen[i] {
+				t.Fatalf("index (%d) was selected more than once", i)
+			}
+			seen[i] = true
+			cnt[i]++
+		}
+	}
+	errSize := 0.02
+	for _, v := range cnt {
+		freq := float64(v) / float64(len(samples))
+		expected := float64(expectedLen) / float64(n)
+		if expected+errSize < freq || expected-errSize > freq {
+			t.Errorf("inclusion frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+		}
+	}
+}
+
+func TestSampleWithoutReplacement(t *testing.T) {
+	iterations := 20000
+	rand.Seed(0)
+	for _, tc := range uniformSampleCases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := make([][]int, iterations)
+			for i := range samples {
+				samples[i] = SampleWithoutReplacement(tc.n, tc.k)
+				if !sort.IntsAreSorted(samples[i]) {
+					t.Fatalf("indices %v are not sorted", samples[i])
+				}
+			}
+			checkUniformSample(t, tc.n, tc.k, samples)
+		})
+	}
+}
+
+func TestReservoir(t *testing.T) {
+	iterations := 20000
+	rand.Seed(0)
+	for _, tc := range uniformSampleCases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := make([][]int, iterations)
+			for i := range samples {
+				r := NewReservoir(tc.k)
+				for j := 0; j < tc.n; j++ {
+					r.Add()
+				}
+				samples[i] = r.Indices()
+			}
+			checkUniformSample(t, tc.n, tc.k, samples)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package randomvariate
+
+import "math"
+
+// Skellam draws a sample from a Skellam distribution, the difference
+// between two independent Poisson counts with means mu1 and mu2.
+func Skellam(mu1, mu2 float64) int {
+	return poissonCount(mu1) - poissonCount(mu2)
+}
+
+// SkellamPMF returns the probability that the difference between two
+// independent Poisson counts with means mu1 and mu2 is equal to k.
+// The probability is given by
+//
+//	exp(-(mu1 + mu2)) * (mu1/mu2)^(k/2) * I_|k|(2 * sqrt(mu1 * mu2))
+//
+// where I is the modified Bessel function of the first kind.
+func SkellamPMF(k int, mu1, mu2 float64) float64 {
+	if mu2 == 0 {
+		return PoissonPMF(k, mu1)
+	} else if mu1 == 0 {
+		return PoissonPMF(-k, mu2)
+	}
+	logP := -(mu1 + mu2) + float64(k)/2*math.Log(mu1/mu2) +
+		LogBesselI(k, 2*math.Sqrt(mu1*mu2))
+	return math.Exp(logP)
+}
+
+// BivariatePoisson draws a pair of correlated counts from a bivariate
+// Poisson distribution. The pair is constructed as (Y1 + Y0, Y2 + Y0) where
+// Y1, Y2 and Y0 are independent Poisson counts with means l1, l2 and l0.
+// Each count is marginally Poisson, with means l1 + l0 and l2 + l0, and
+// the covariance between the two counts is l0.
+func BivariatePoisson(l1, l2, l0 float64) (int, int) {
+	shared := poissonCount(l0)
+	return poissonCount(l1) + shared, poissonCount(l2) + shared
+}
+
+// BivariatePoissonPMF returns the probability of observing the pair of
+// counts (x, y) from a bivariate Poisson distribution with parameters l1,
+// l2 and l0.
+func BivariatePoissonPMF(x, y int, l1, l2, l0 float64) float64 {
+	if x < 0 || y < 0 {
+		return 0
+	}
+	// Sum over the possible values of the shared count
+	n := x
+	if y < n {
+		n = y
+	}
+	logTerms := make([]float64, 0, n+1)
+	logMax := math.Inf(-1)
+	for i := 0; i <= n; i++ {
+		v := poissonLogPMF(x-i, l1) + poissonLogPMF(y-i, l2) + poissonLogPMF(i, l0)
+		logTerms = append(logTerms, v)
+		logMax = math.Max(logMax, v)
+	}
+	if math.IsInf(logMax, -1) {
+		return 0
+	}
+	var sum float64
+	for _, v := range logTerms {
+		sum += math.Exp(v - logMax)
+	}
+	return math.Exp(logMax) * sum
+}
@@ -0,0 +1,120 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSkellam(t *testing.T) {
+	cases := []struct {
+		name     string
+		mu1, mu2 float64
+	}{
+		{name: "mu1=1,mu2=1",
+			mu1: 1.0,
+			mu2: 1.0,
+		},
+		{name: "mu1=5,mu2=2",
+			mu1: 5.0,
+			mu2: 2.0,
+		},
+		{name: "mu1=0.5,mu2=0",
+			mu1: 0.5,
+			mu2: 0.0,
+		},
+		{name: "mu1=100,mu2=80",
+			mu1: 100.0,
+			mu2: 80.0,
+		},
+	}
+	iterations := 100000
+	errSize := 0.01
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			cnt := make(map[int]int)
+			for i := 0; i < iterations; i++ {
+				cnt[Skellam(tc.mu1, tc.mu2)]++
+			}
+			// Check frequency against PMF
+			var total float64
+			for k := -200; k <= 200; k++ {
+				p := SkellamPMF(k, tc.mu1, tc.mu2)
+				total += p
+				freq := float64(cnt[k]) / float64(iterations)
+				if p+errSize < freq || p-errSize > freq {
+					t.Errorf("frequency of %d (%f) is greater than expected (%f) +/- (%f)", k, freq, p, errSize)
+				}
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("PMF sums to %f instead of 1", total)
+			}
+		})
+	}
+}
+
+func TestBivariatePoisson(t *testing.T) {
+	cases := []struct {
+		name       string
+		l1, l2, l0 float64
+	}{
+		{name: "l1=1,l2=1,l0=0",
+			l1: 1.0,
+			l2: 1.0,
+			l0: 0.0,
+		},
+		{name: "l1=2,l2=3,l0=1",
+			l1: 2.0,
+			l2: 3.0,
+			l0: 1.0,
+		},
+		{name: "l1=0.5,l2=0,l0=4",
+			l1: 0.5,
+			l2: 0.0,
+			l0: 4.0,
+		},
+	}
+	iterations := 100000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var sumX, sumY, sumXY float64
+			for i := 0; i < iterations; i++ {
+				x, y := BivariatePoisson(tc.l1, tc.l2, tc.l0)
+				sumX += float64(x)
+				sumY += float64(y)
+				sumXY += float64(x * y)
+			}
+			// Check means and covariance
+			meanX := sumX / float64(iterations)
+			meanY := sumY / float64(iterations)
+			cov := sumXY/float64(iterations) - meanX*meanY
+			expected := tc.l1 + tc.l0
+			if err := expected * errSize; expected+err <= meanX || expected-err >= meanX {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", meanX, expected, err)
+			}
+			expected = tc.l2 + tc.l0
+			if err := expected * errSize; expected+err <= meanY || expected-err >= meanY {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", meanY, expected, err)
+			}
+			expected = tc.l0
+			if err := errSize * 2; expected+err <= cov || expected-err >= cov {
+				t.Errorf("covariance (%f) is greater than expected (%f) +/- (%f)", cov, expected, err)
+			}
+			// Check that the PMF sums to 1
+			var total float64
+			for x := 0; x < 60; x++ {
+				for y := 0; y < 60; y++ {
+					total += BivariatePoissonPMF(x, y, tc.l1, tc.l2, tc.l0)
+				}
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("PMF sums to %f instead of 1", total)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package randomvariate
+
+// SparseProbabilities is a probability vector over Size categories where
+// only the categories with non-zero probability are stored. Indices holds
+// the category indices in ascending order and Probs holds their
+// corresponding probabilities.
+type SparseProbabilities struct {
+	Size    int
+	Indices []int
+	Probs   []float64
+}
+
+// NewSparseProbabilities converts a dense probability vector into its
+// sparse representation, keeping only the categories with non-zero
+// probability.
+func NewSparseProbabilities(p []float64) *SparseProbabilities {
+	s := &SparseProbabilities{Size: len(p)}
+	for i, prob := range p {
+		if prob != 0 {
+			s.Indices = append(s.Indices, i)
+			s.Probs = append(s.Probs, prob)
+		}
+	}
+	return s
+}
+
+// Dense converts the sparse probability vector back into a dense vector of
+// length Size.
+func (s *SparseProbabilities) Dense() []float64 {
+	p := make([]float64, s.Size)
+	for i, idx := range s.Indices {
+		p[idx] = s.Probs[i]
+	}
+	return p
+}
+
+// SparseMultinomial draws n samples from a sparse probability distribution.
+// Only the categories with non-zero probability are scanned, so the cost
+// does not depend on the total number of categories. Returns a map from
+// category index to count that only contains categories that were drawn at
+// least once.
+func SparseMultinomial(n int, p *SparseProbabilities) map[int]int {
+	result := make(map[int]int)
+	if n == 0 || len(p.Probs) == 0 {
+		return result
+	}
+	table := newGuideTable(p.Probs)
+	for i := 0; i < n; i++ {
+		result[p.Indices[table.draw()]]++
+	}
+	return result
+}
+
+// SparseCountsToDense converts a sparse count map, as returned by
+// SparseMultinomial, into a dense count vector of length size.
+func SparseCountsToDense(counts map[int]int, size int) []int {
+	result := make([]int, size)
+	for idx, c := range counts {
+		result[idx] = c
+	}
+	return result
+}
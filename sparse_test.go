@@ -0,0 +1,112 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSparseProbabilities(t *testing.T) {
+	cases := []struct {
+		name    string
+		p       []float64
+		indices []int
+		probs   []float64
+	}{
+		{name: "plen=5,nonzero=2",
+			p:       []float64{0.0, 0.25, 0.0, 0.0, 0.75},
+			indices: []int{1, 4},
+			probs:   []float64{0.25, 0.75},
+		},
+		{name: "plen=3,nonzero=3",
+			p:       []float64{0.2, 0.3, 0.5},
+			indices: []int{0, 1, 2},
+			probs:   []float64{0.2, 0.3, 0.5},
+		},
+		{name: "plen=2,nonzero=0",
+			p:       []float64{0.0, 0.0},
+			indices: nil,
+			probs:   nil,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewSparseProbabilities(tc.p)
+			if s.Size != len(tc.p) {
+				t.Errorf("expected size %d, instead got %d", len(tc.p), s.Size)
+			}
+			if !reflect.DeepEqual(s.Indices, tc.indices) {
+				t.Errorf("expected indices %v, instead got %v", tc.indices, s.Indices)
+			}
+			if !reflect.DeepEqual(s.Probs, tc.probs) {
+				t.Errorf("expected probabilities %v, instead got %v", tc.probs, s.Probs)
+			}
+			if dense := s.Dense(); !reflect.DeepEqual(dense, tc.p) {
+				t.Errorf("expected dense vector %v, instead got %v", tc.p, dense)
+			}
+		})
+	}
+}
+
+func TestSparseMultinomial(t *testing.T) {
+	cases := []struct {
+		name string
+		n    int
+		p    []float64
+	}{
+		{name: "n=1,plen=2,dist=uniform",
+			n: 1,
+			p: []float64{0.5, 0.5},
+		},
+		{name: "n=1,plen=6,dist=sparse",
+			n: 1,
+			p: []float64{0.0, 0.0, 0.3, 0.0, 0.7, 0.0},
+		},
+		{name: "n=2,plen=1000,dist=sparse",
+			n: 2,
+			p: func() []float64 {
+				p := make([]float64, 1000)
+				p[10], p[500], p[999] = 0.2, 0.3, 0.5
+				return p
+			}(),
+		},
+		{name: "n=5,plen=3,dist=skew_left",
+			n: 5,
+			p: []float64{0.6, 0.3, 0.1},
+		},
+	}
+	iterations := 1000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewSparseProbabilities(tc.p)
+			// Simulate
+			sum := make([]int, len(tc.p))
+			for i := 0; i < iterations; i++ {
+				counts := SparseMultinomial(tc.n, s)
+				var total int
+				for idx, c := range counts {
+					if tc.p[idx] == 0 {
+						t.Fatalf("category %d has zero probability", idx)
+					}
+					total += c
+				}
+				if total != tc.n {
+					t.Fatalf("expected %d draws, instead got %d", tc.n, total)
+				}
+				for c, v := range SparseCountsToDense(counts, len(tc.p)) {
+					sum[c] += v
+				}
+			}
+			// Check frequency
+			for i, v := range sum {
+				freq := float64(v) / float64(iterations*tc.n)
+				expected := tc.p[i]
+				if expected+errSize < freq || expected-errSize > freq {
+					t.Errorf("frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// UniformSphere draws a point uniformly distributed on the surface of the
+// unit sphere in d dimensions and stores it in dst. If dst is too short to
+// hold d coordinates, a new slice is allocated. The point is a vector of
+// standard normal variates divided by its length. If d <= 0, an empty
+// slice is returned.
+func UniformSphere(dst []float64, d int) []float64 {
+	if d <= 0 {
+		return dst[:0]
+	}
+	if len(dst) < d {
+		dst = make([]float64, d)
+	}
+	dst = dst[:d]
+	for {
+		var norm float64
+		for i := range dst {
+			dst[i] = rand.NormFloat64()
+			norm += dst[i] * dst[i]
+		}
+		// Redraw in the unlikely event that every coordinate is zero
+		if norm > 0 {
+			norm = math.Sqrt(norm)
+			for i := range dst {
+				dst[i] /= norm
+			}
+			return dst
+		}
+	}
+}
+
+// UniformBall draws a point uniformly distributed in the unit ball in d
+// dimensions and stores it in dst. If dst is too short to hold d
+// coordinates, a new slice is allocated. A uniform direction is scaled by
+// a radius of U^(1/d).
+func UniformBall(dst []float64, d int) []float64 {
+	dst = UniformSphere(dst, d)
+	r := math.Pow(rand.Float64(), 1/float64(d))
+	for i := range dst {
+		dst[i] *= r
+	}
+	return dst
+}
+
+// UniformSimplex draws a point uniformly distributed on the probability
+// simplex of d coordinates, that is, d non-negative coordinates that sum
+// to 1, and stores it in dst. If dst is too short to hold d coordinates, a
+// new slice is allocated. This is a flat Dirichlet distribution, obtained
+// by normalizing d standard exponential variates.
+func UniformSimplex(dst []float64, d int) []float64 {
+	if len(dst) < d {
+		dst = make([]float64, d)
+	}
+	dst = dst[:d]
+	var sum float64
+	for i := range dst {
+		dst[i] = rand.ExpFloat64()
+		sum += dst[i]
+	}
+	for i := range dst {
+		dst[i] /= sum
+	}
+	return dst
+}
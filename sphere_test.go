@@ -0,0 +1,123 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestUniformSphere(t *testing.T) {
+	iterations := 20000
+	errSize := 0.03
+	rand.Seed(0)
+	for _, d := range []int{1, 2, 3, 10} {
+		sum := make([]float64, d)
+		sumSq := make([]float64, d)
+		for i := 0; i < iterations; i++ {
+			x := UniformSphere(nil, d)
+			var norm float64
+			for j, v := range x {
+				norm += v * v
+				sum[j] += v
+				sumSq[j] += v * v
+			}
+			if math.Abs(norm-1) > 1e-12 {
+				t.Fatalf("d=%d: expected a unit vector, instead got length %f", d, math.Sqrt(norm))
+			}
+		}
+		for j := range sum {
+			mean := sum[j] / float64(iterations)
+			secondMoment := sumSq[j] / float64(iterations)
+			if errSize < mean || -errSize > mean {
+				t.Errorf("d=%d: mean (%f) is greater than expected (%f) +/- (%f)", d, mean, 0.0, errSize)
+			}
+			if expected := 1 / float64(d); expected+errSize < secondMoment || expected-errSize > secondMoment {
+				t.Errorf("d=%d: second moment (%f) is greater than expected (%f) +/- (%f)", d, secondMoment, expected, errSize)
+			}
+		}
+	}
+}
+
+func TestUniformBall(t *testing.T) {
+	iterations := 20000
+	errSize := 0.02
+	rand.Seed(0)
+	buf := make([]float64, 5)
+	for _, d := range []int{1, 2, 3, 5} {
+		var inner int
+		var sumSq float64
+		for i := 0; i < iterations; i++ {
+			x := UniformBall(buf, d)
+			if len(x) != d {
+				t.Fatalf("expected %d coordinates, instead got %d", d, len(x))
+			}
+			var r2 float64
+			for _, v := range x {
+				r2 += v * v
+			}
+			if r2 > 1 {
+				t.Fatalf("d=%d: point is outside the unit ball", d)
+			}
+			if r2 < 0.25 {
+				inner++
+			}
+			sumSq += r2
+		}
+		// The volume of the ball of radius 1/2 is 2^-d of the unit ball
+		freq := float64(inner) / float64(iterations)
+		if expected := math.Pow(0.5, float64(d)); expected+errSize < freq || expected-errSize > freq {
+			t.Errorf("d=%d: frequency (%f) is greater than expected (%f) +/- (%f)", d, freq, expected, errSize)
+		}
+		mean := sumSq / float64(iterations)
+		if expected := float64(d) / float64(d+2); expected+errSize < mean || expected-errSize > mean {
+			t.Errorf("d=%d: mean squared radius (%f) is greater than expected (%f) +/- (%f)", d, mean, expected, errSize)
+		}
+	}
+}
+
+func TestUniformSimplex(t *testing.T) {
+	iterations := 20000
+	errSize := 0.01
+	rand.Seed(0)
+	for _, d := range []int{1, 2, 3, 8} {
+		sum := make([]float64, d)
+		sumSq := make([]float64, d)
+		for i := 0; i < iterations; i++ {
+			x := UniformSimplex(nil, d)
+			var total float64
+			for j, v := range x {
+				if v < 0 {
+					t.Fatalf("d=%d: expected non-negative coordinates, instead got %f", d, v)
+				}
+				total += v
+				sum[j] += v
+				sumSq[j] += v * v
+			}
+			if math.Abs(total-1) > 1e-12 {
+				t.Fatalf("d=%d: expected coordinates to sum to 1, instead got %f", d, total)
+			}
+		}
+		n := float64(d)
+		for j := range sum {
+			mean := sum[j] / float64(iterations)
+			variance := sumSq[j]/float64(iterations) - mean*mean
+			if expected := 1 / n; expected+errSize < mean || expected-errSize > mean {
+				t.Errorf("d=%d: mean (%f) is greater than expected (%f) +/- (%f)", d, mean, expected, errSize)
+			}
+			if expected := (n - 1) / (n * n * (n + 1)); expected+errSize < variance || expected-errSize > variance {
+				t.Errorf("d=%d: variance (%f) is greater than expected (%f) +/- (%f)", d, variance, expected, errSize)
+			}
+		}
+	}
+}
+
+func TestUniformSphereEmpty(t *testing.T) {
+	for _, d := range []int{0, -1} {
+		if x := UniformSphere(make([]float64, 3), d); len(x) != 0 {
+			t.Errorf("d=%d: expected an empty slice, instead got %v", d, x)
+		}
+		if x := UniformBall(nil, d); len(x) != 0 {
+			t.Errorf("d=%d: expected an empty slice, instead got %v", d, x)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Stable draws a sample from an alpha-stable distribution with stability
+// index alpha in (0, 2], skewness beta in [-1, 1], scale sigma and
+// location mu, in the parameterization of Samorodnitsky and Taqqu.
+// Uses the method of Chambers, Mallows and Stuck (1976). The distribution
+// is a normal distribution with variance 2*sigma^2 when alpha = 2 and a
+// Cauchy distribution when alpha = 1 and beta = 0.
+func Stable(alpha, beta, sigma, mu float64) float64 {
+	v := math.Pi * (rand.Float64() - 0.5)
+	w := rand.ExpFloat64()
+
+	if alpha == 1 {
+		halfPi := math.Pi / 2
+		x := ((halfPi+beta*v)*math.Tan(v) -
+			beta*math.Log(halfPi*w*math.Cos(v)/(halfPi+beta*v))) / halfPi
+		return sigma*x + beta*sigma*math.Log(sigma)/halfPi + mu
+	}
+
+	t := beta * math.Tan(math.Pi*alpha/2)
+	b := math.Atan(t) / alpha
+	s := math.Pow(1+t*t, 1/(2*alpha))
+	x := s * math.Sin(alpha*(v+b)) / math.Pow(math.Cos(v), 1/alpha) *
+		math.Pow(math.Cos(v-alpha*(v+b))/w, (1-alpha)/alpha)
+	return sigma*x + mu
+}
@@ -0,0 +1,57 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStable(t *testing.T) {
+	cases := []struct {
+		name                   string
+		alpha, beta, sigma, mu float64
+		cdf                    func(x float64) float64
+	}{
+		{name: "alpha=2,beta=0,sigma=1,mu=0",
+			alpha: 2.0, beta: 0.0, sigma: 1.0, mu: 0.0,
+			cdf: func(x float64) float64 { return NormalCDF(x, 0, math.Sqrt2) },
+		},
+		{name: "alpha=2,beta=0.5,sigma=3,mu=1",
+			alpha: 2.0, beta: 0.5, sigma: 3.0, mu: 1.0,
+			cdf: func(x float64) float64 { return NormalCDF(x, 1, 3*math.Sqrt2) },
+		},
+		{name: "alpha=1,beta=0,sigma=1,mu=0",
+			alpha: 1.0, beta: 0.0, sigma: 1.0, mu: 0.0,
+			cdf: func(x float64) float64 { return CauchyCDF(x, 0, 1) },
+		},
+		{name: "alpha=1,beta=0,sigma=2,mu=-3",
+			alpha: 1.0, beta: 0.0, sigma: 2.0, mu: -3.0,
+			cdf: func(x float64) float64 { return CauchyCDF(x, -3, 2) },
+		},
+		{name: "alpha=0.5,beta=1,sigma=1,mu=0",
+			alpha: 0.5, beta: 1.0, sigma: 1.0, mu: 0.0,
+			// Levy distribution
+			cdf: func(x float64) float64 {
+				if x <= 0 {
+					return 0
+				}
+				return math.Erfc(math.Sqrt(1 / (2 * x)))
+			},
+		},
+		{name: "alpha=0.5,beta=1,sigma=2,mu=1",
+			alpha: 0.5, beta: 1.0, sigma: 2.0, mu: 1.0,
+			cdf: func(x float64) float64 {
+				if x <= 1 {
+					return 0
+				}
+				return math.Erfc(math.Sqrt(2 / (2 * (x - 1))))
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Stable(tc.alpha, tc.beta, tc.sigma, tc.mu) }
+			points := []float64{tc.mu - 2*tc.sigma, tc.mu, tc.mu + tc.sigma, tc.mu + 5*tc.sigma}
+			checkEmpiricalCDF(t, draw, tc.cdf, points)
+		})
+	}
+}
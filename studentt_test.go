@@ -0,0 +1,68 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStudentT(t *testing.T) {
+	cases := []struct {
+		name   string
+		nu     float64
+		points []float64
+	}{
+		{name: "nu=1", nu: 1.0, points: []float64{-3, -1, 0, 1, 3}},
+		{name: "nu=3", nu: 3.0, points: []float64{-2, -0.5, 0.5, 2}},
+		{name: "nu=30", nu: 30.0, points: []float64{-2, -1, 0, 1, 2}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return StudentT(tc.nu) }
+			cdf := func(x float64) float64 { return StudentTCDF(x, tc.nu) }
+			pdf := func(x float64) float64 { return StudentTPDF(x, tc.nu) }
+			checkEmpiricalCDF(t, draw, cdf, tc.points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.points[0], tc.points[len(tc.points)-1])
+		})
+	}
+	// Upper 2.5% critical value with ten degrees of freedom
+	if v := StudentTCDF(2.228138851986274, 10); math.Abs(v-0.975) > 1e-12 {
+		t.Errorf("expected value is %e, instead got %e", 0.975, v)
+	}
+	// One degree of freedom is the Cauchy distribution
+	if v, expected := StudentTCDF(2, 1), 0.5+math.Atan(2)/math.Pi; math.Abs(v-expected) > 1e-12 {
+		t.Errorf("expected value is %e, instead got %e", expected, v)
+	}
+}
+
+func TestNoncentralStudentT(t *testing.T) {
+	cases := []struct {
+		name      string
+		nu, delta float64
+		points    []float64
+	}{
+		{name: "nu=5,delta=0", nu: 5.0, delta: 0.0, points: []float64{-2, -0.5, 0.5, 2}},
+		{name: "nu=5,delta=1", nu: 5.0, delta: 1.0, points: []float64{-1, 0, 1, 2, 4}},
+		{name: "nu=10,delta=-2", nu: 10.0, delta: -2.0, points: []float64{-4, -3, -2, -1, 0}},
+		{name: "nu=20,delta=5", nu: 20.0, delta: 5.0, points: []float64{3.5, 4.5, 5.5, 7}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return NoncentralStudentT(tc.nu, tc.delta) }
+			cdf := func(x float64) float64 { return NoncentralStudentTCDF(x, tc.nu, tc.delta) }
+			pdf := func(x float64) float64 { return NoncentralStudentTPDF(x, tc.nu, tc.delta) }
+			checkEmpiricalCDF(t, draw, cdf, tc.points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.points[0], tc.points[len(tc.points)-1])
+		})
+	}
+	// Zero noncentrality reduces to the central distribution
+	for _, x := range []float64{-3, -0.2, 0, 1.5} {
+		expected := StudentTCDF(x, 7)
+		if v := NoncentralStudentTCDF(x, 7, 0); math.Abs(v-expected) > 1e-12 {
+			t.Errorf("expected value is %e, instead got %e", expected, v)
+		}
+		expected = StudentTPDF(x, 7)
+		if v := NoncentralStudentTPDF(x, 7, 0); math.Abs(v-expected) > 1e-9 {
+			t.Errorf("expected value is %e, instead got %e", expected, v)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// TruncatedNormal draws a sample from a normal distribution with mean mu
+// and standard deviation sigma truncated to the interval [a, b]. Either
+// bound may be infinite.
+// One-sided tails are drawn by Robert's (1995) rejection sampler with an
+// optimally scaled exponential proposal, which remains efficient when the
+// bound is many standard deviations away from the mean. Two-sided
+// intervals are drawn by the method of Chopin (2011), which samples from a
+// table of equal-area rectangles covering the density, and one-sided
+// intervals that contain the mean by rejection from the untruncated
+// normal.
+func TruncatedNormal(mu, sigma, a, b float64) float64 {
+	alpha := (a - mu) / sigma
+	beta := (b - mu) / sigma
+	var z float64
+	if math.IsInf(alpha, -1) || math.IsInf(beta, 1) {
+		if alpha >= 0 {
+			z = truncatedNormalTail(alpha, beta)
+		} else if beta <= 0 {
+			z = -truncatedNormalTail(-beta, -alpha)
+		} else {
+			// The interval holds at least half of the mass
+			for {
+				z = rand.NormFloat64()
+				if z >= alpha && z <= beta {
+					break
+				}
+			}
+		}
+	} else {
+		z = truncatedNormalChopin(alpha, beta)
+	}
+	return mu + sigma*z
+}
+
+// truncatedNormalTail draws a standard normal variate truncated to
+// [alpha, beta] where 0 <= alpha < beta.
+func truncatedNormalTail(alpha, beta float64) float64 {
+	// Optimal rate of the exponential proposal
+	lambda := (alpha + math.Sqrt(alpha*alpha+4)) / 2
+	// Width beyond which the exponential proposal is more efficient than a
+	// uniform proposal over [alpha, beta]
+	width := math.Sqrt(math.E) / lambda * math.Exp((alpha*alpha-alpha*math.Sqrt(alpha*alpha+4))/4)
+
+	if beta > alpha+width {
+		for {
+			z := alpha + rand.ExpFloat64()/lambda
+			if z > beta {
+				continue
+			}
+			d := z - lambda
+			if math.Log(rand.Float64()) <= -d*d/2 {
+				return z
+			}
+		}
+	}
+	for {
+		z := Uniform(alpha, beta)
+		if math.Log(rand.Float64()) <= (alpha*alpha-z*z)/2 {
+			return z
+		}
+	}
+}
+
+// chopinTable covers the standard normal density exp(-x^2/2) over
+// [-xmax, xmax] with rectangles of equal area. Only the rectangles over
+// [0, xmax] are stored; those over [-xmax, 0] are their mirror images.
+// Rectangle i spans [x[i], x[i+1]] with height exp(-x[i]^2/2), the
+// maximum of the density over the rectangle.
+type chopinTable struct {
+	x []float64
+}
+
+// chopinArea is the area of each rectangle in the table. Smaller areas
+// give more rectangles and a higher acceptance rate.
+const chopinArea = 6.25e-4
+
+// chopinXmax is the approximate extent of the table, as in Chopin (2011).
+// Beyond it, the table ends at the first rectangle edge past this point.
+const chopinXmax = 3.48672170399
+
+var truncatedNormalTable = newChopinTable()
+
+// newChopinTable builds the table from 0 outwards so that every rectangle
+// has an area of exactly chopinArea.
+func newChopinTable() *chopinTable {
+	x := []float64{0}
+	for x[len(x)-1] < chopinXmax {
+		last := x[len(x)-1]
+		x = append(x, last+chopinArea/math.Exp(-last*last/2))
+	}
+	return &chopinTable{x: x}
+}
+
+// xmax returns the upper end of the table.
+func (t *chopinTable) xmax() float64 {
+	return t.x[len(t.x)-1]
+}
+
+// index returns the index of the rectangle containing z, where z is in
+// [-xmax, xmax]. Rectangles over [0, xmax] have indices 0, 1, ..., and
+// their mirror images over [-xmax, 0] have indices -1, -2, ....
+func (t *chopinTable) index(z float64) int {
+	last := len(t.x) - 2
+	if z >= 0 {
+		i := sort.SearchFloat64s(t.x, z)
+		if i < len(t.x) && t.x[i] == z {
+			i++
+		}
+		if i-1 > last {
+			return last
+		}
+		return i - 1
+	}
+	i := sort.SearchFloat64s(t.x, -z)
+	if i-1 > last {
+		return -last - 1
+	}
+	if i == 0 {
+		return -1
+	}
+	return -i
+}
+
+// rect returns the lower and upper ends of rectangle k and the minimum
+// and maximum of the density over it.
+func (t *chopinTable) rect(k int) (lo, hi, ymin, ymax float64) {
+	i := k
+	if k < 0 {
+		i = -k - 1
+	}
+	lo, hi = t.x[i], t.x[i+1]
+	ymax, ymin = math.Exp(-lo*lo/2), math.Exp(-hi*hi/2)
+	if k < 0 {
+		lo, hi = -hi, -lo
+	}
+	return lo, hi, ymin, ymax
+}
+
+// truncatedNormalChopin draws a standard normal variate truncated to the
+// finite interval [alpha, beta]. The part of the interval inside the table
+// is sampled by choosing a rectangle uniformly among those overlapping the
+// interval, a point uniformly inside it, and accepting the point if it lies
+// under the density. Parts beyond the table are drawn by Robert's tail
+// sampler, chosen with probability proportional to their mass.
+func truncatedNormalChopin(alpha, beta float64) float64 {
+	t := truncatedNormalTable
+	xmax := t.xmax()
+	if alpha >= xmax {
+		return truncatedNormalTail(alpha, beta)
+	} else if beta <= -xmax {
+		return -truncatedNormalTail(-beta, -alpha)
+	}
+
+	// Pick between the table and the tails beyond it
+	lo, hi := math.Max(alpha, -xmax), math.Min(beta, xmax)
+	if alpha < -xmax || beta > xmax {
+		var left, right float64
+		if alpha < -xmax {
+			left = truncatedNormalMass(0, 1, alpha, -xmax)
+		}
+		if beta > xmax {
+			right = truncatedNormalMass(0, 1, xmax, beta)
+		}
+		u := rand.Float64() * (left + truncatedNormalMass(0, 1, lo, hi) + right)
+		if u < left {
+			return -truncatedNormalTail(xmax, -alpha)
+		} else if u < left+right {
+			return truncatedNormalTail(xmax, beta)
+		}
+	}
+
+	ka, kb := t.index(lo), t.index(hi)
+	if kb-ka < 4 {
+		// The interval spans only a few rectangles, over which the density
+		// changes little, so a uniform proposal is efficient
+		ymax := 1.0
+		if lo > 0 {
+			ymax = math.Exp(-lo * lo / 2)
+		} else if hi < 0 {
+			ymax = math.Exp(-hi * hi / 2)
+		}
+		for {
+			z := Uniform(lo, hi)
+			if rand.Float64()*ymax <= math.Exp(-z*z/2) {
+				return z
+			}
+		}
+	}
+	for {
+		rlo, rhi, ymin, ymax := t.rect(ka + rand.Intn(kb-ka+1))
+		z := rlo + (rhi-rlo)*rand.Float64()
+		if z < lo || z > hi {
+			continue
+		}
+		y := ymax * rand.Float64()
+		if y <= ymin || y <= math.Exp(-z*z/2) {
+			return z
+		}
+	}
+}
+
+// TruncatedNormalPDF returns the probability density at x of a normal
+// distribution with mean mu and standard deviation sigma truncated to the
+// interval [a, b].
+func TruncatedNormalPDF(x, mu, sigma, a, b float64) float64 {
+	if x < a || x > b {
+		return 0
+	}
+	return NormalPDF(x, mu, sigma) / truncatedNormalMass(mu, sigma, a, b)
+}
+
+// TruncatedNormalCDF returns the cumulative probability at x of a normal
+// distribution with mean mu and standard deviation sigma truncated to the
+// interval [a, b].
+func TruncatedNormalCDF(x, mu, sigma, a, b float64) float64 {
+	if x <= a {
+		return 0
+	} else if x >= b {
+		return 1
+	}
+	return truncatedNormalMass(mu, sigma, a, x) / truncatedNormalMass(mu, sigma, a, b)
+}
+
+// truncatedNormalMass returns the probability that a normal variate lies
+// in [a, b]. When the interval lies above the mean, the difference is
+// computed between upper tail probabilities to avoid cancellation.
+func truncatedNormalMass(mu, sigma, a, b float64) float64 {
+	if a > mu {
+		return NormalCDF(-a, -mu, sigma) - NormalCDF(-b, -mu, sigma)
+	}
+	return NormalCDF(b, mu, sigma) - NormalCDF(a, mu, sigma)
+}
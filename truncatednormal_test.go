@@ -0,0 +1,118 @@
+package randomvariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTruncatedNormal(t *testing.T) {
+	inf := math.Inf(1)
+	cases := []struct {
+		name            string
+		mu, sigma, a, b float64
+		points          []float64
+	}{
+		{name: "interval=[-1,1]",
+			mu: 0.0, sigma: 1.0, a: -1.0, b: 1.0,
+			points: []float64{-0.9, -0.3, 0.2, 0.8},
+		},
+		{name: "interval=[-10,10]",
+			mu: 0.0, sigma: 1.0, a: -10.0, b: 10.0,
+			points: []float64{-2, -0.5, 0.5, 2},
+		},
+		{name: "interval=[0,inf)",
+			mu: 0.0, sigma: 1.0, a: 0.0, b: inf,
+			points: []float64{0.1, 0.5, 1, 2.5},
+		},
+		{name: "interval=[2,inf),mu=1,sigma=2",
+			mu: 1.0, sigma: 2.0, a: 2.0, b: inf,
+			points: []float64{2.2, 3, 5, 8},
+		},
+		{name: "interval=[8,inf)",
+			mu: 0.0, sigma: 1.0, a: 8.0, b: inf,
+			points: []float64{8.01, 8.05, 8.15, 8.4},
+		},
+		{name: "interval=[5,5.2]",
+			mu: 0.0, sigma: 1.0, a: 5.0, b: 5.2,
+			points: []float64{5.02, 5.08, 5.14, 5.19},
+		},
+		{name: "interval=(-inf,-6]",
+			mu: 0.0, sigma: 1.0, a: -inf, b: -6.0,
+			points: []float64{-6.5, -6.2, -6.1, -6.02},
+		},
+		{name: "interval=[-12,-11]",
+			mu: 0.0, sigma: 1.0, a: -12.0, b: -11.0,
+			points: []float64{-11.2, -11.1, -11.05, -11.01},
+		},
+		{name: "interval=[-3,2]",
+			mu: 0.0, sigma: 1.0, a: -3.0, b: 2.0,
+			points: []float64{-1.5, -0.2, 0.7, 1.6},
+		},
+		{name: "interval=[-5,5]",
+			mu: 0.0, sigma: 1.0, a: -5.0, b: 5.0,
+			points: []float64{-3.6, -1, 1, 3.6},
+		},
+		{name: "interval=[3,4.5]",
+			mu: 0.0, sigma: 1.0, a: 3.0, b: 4.5,
+			points: []float64{3.1, 3.3, 3.5, 3.8},
+		},
+		{name: "interval=[-4,-3.2]",
+			mu: 0.0, sigma: 1.0, a: -4.0, b: -3.2,
+			points: []float64{-3.7, -3.5, -3.4, -3.3},
+		},
+		{name: "interval=[0.5,0.52]",
+			mu: 0.0, sigma: 1.0, a: 0.5, b: 0.52,
+			points: []float64{0.502, 0.508, 0.513, 0.518},
+		},
+		{name: "interval=[-0.01,0.01]",
+			mu: 0.0, sigma: 1.0, a: -0.01, b: 0.01,
+			points: []float64{-0.008, -0.002, 0.003, 0.009},
+		},
+		{name: "interval=(-inf,1],mu=2",
+			mu: 2.0, sigma: 1.0, a: -inf, b: 1.0,
+			points: []float64{-1, 0, 0.5, 0.9},
+		},
+		{name: "interval=[20,30],mu=-5,sigma=3",
+			mu: -5.0, sigma: 3.0, a: 20.0, b: 30.0,
+			points: []float64{20.05, 20.2, 20.5, 21},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 {
+				x := TruncatedNormal(tc.mu, tc.sigma, tc.a, tc.b)
+				if x < tc.a || x > tc.b {
+					t.Fatalf("value (%f) is outside [%f, %f]", x, tc.a, tc.b)
+				}
+				return x
+			}
+			cdf := func(x float64) float64 { return TruncatedNormalCDF(x, tc.mu, tc.sigma, tc.a, tc.b) }
+			pdf := func(x float64) float64 { return TruncatedNormalPDF(x, tc.mu, tc.sigma, tc.a, tc.b) }
+			checkEmpiricalCDF(t, draw, cdf, tc.points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, tc.points[0], tc.points[len(tc.points)-1])
+		})
+	}
+}
+
+func TestChopinTable(t *testing.T) {
+	table := truncatedNormalTable
+	if table.xmax() < chopinXmax {
+		t.Fatalf("expected table to reach %f, instead it ends at %f", chopinXmax, table.xmax())
+	}
+	last := len(table.x) - 2
+	for k := -last - 1; k <= last; k++ {
+		lo, hi, ymin, ymax := table.rect(k)
+		if area := (hi - lo) * ymax; math.Abs(area-chopinArea) > 1e-12 {
+			t.Fatalf("expected rectangle %d to have area %e, instead got %e", k, chopinArea, area)
+		}
+		if ymin > ymax {
+			t.Fatalf("expected minimum of rectangle %d to be at most its maximum", k)
+		}
+		// Points inside the rectangle are mapped back to it
+		for _, z := range []float64{lo + 0.25*(hi-lo), lo + 0.75*(hi-lo)} {
+			if i := table.index(z); i != k {
+				t.Fatalf("expected %f to be in rectangle %d, instead got %d", z, k, i)
+			}
+		}
+	}
+}
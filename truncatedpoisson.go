@@ -0,0 +1,146 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// ZeroTruncatedPoisson draws a sample from a Poisson distribution with mean
+// lambda conditional on observing at least one event. For small lambda,
+// the count is drawn by exact inversion of the truncated distribution so
+// that the run time does not blow up as lambda approaches 0. For lambda of
+// at least 10, zero is unlikely and untruncated Poisson draws are simply
+// rejected until a non-zero count is obtained. These come from Poisson,
+// whose run time grows with lambda, below 30 and from PoissonXL above.
+func ZeroTruncatedPoisson(lambda float64) int {
+	if lambda >= 10 {
+		for {
+			if k := poissonCount(lambda); k > 0 {
+				return k
+			}
+		}
+	}
+	// P(X = 1 | X > 0) computed without cancellation for tiny lambda
+	p := lambda / math.Expm1(lambda)
+	cumP := p
+	u := rand.Float64()
+	k := 1
+	for u > cumP && p > 0 {
+		k++
+		p *= lambda / float64(k)
+		cumP += p
+	}
+	return k
+}
+
+// ZeroTruncatedPoissonPMF returns the probability of observing k events
+// from a zero-truncated Poisson distribution with mean lambda before
+// truncation.
+func ZeroTruncatedPoissonPMF(k int, lambda float64) float64 {
+	if k < 1 {
+		return 0
+	}
+	return PoissonPMF(k, lambda) / -math.Expm1(-lambda)
+}
+
+// TruncatedPoisson draws a sample from a Poisson distribution with mean
+// lambda conditional on the count being within the closed interval [a, b].
+// The count is drawn by exact inversion over the probabilities of the
+// interval, which also works when the interval lies far in the tail of the
+// distribution.
+func TruncatedPoisson(lambda float64, a, b int) int {
+	lo, w, total := truncatedPoissonWeights(lambda, a, b)
+	u := rand.Float64() * total
+	for i, v := range w {
+		if u < v {
+			return lo + i
+		}
+		u -= v
+	}
+	return lo + len(w) - 1
+}
+
+// TruncatedPoissonPMF returns the probability of observing k events from a
+// Poisson distribution with mean lambda truncated to the closed interval
+// [a, b].
+func TruncatedPoissonPMF(k int, lambda float64, a, b int) float64 {
+	lo, w, total := truncatedPoissonWeights(lambda, a, b)
+	if k < lo || k >= lo+len(w) {
+		return 0
+	}
+	return w[k-lo] / total
+}
+
+// truncatedPoissonWeights computes unnormalized Poisson probabilities over
+// the interval [a, b] relative to the most likely count within the
+// interval. Counts whose relative probability is negligible are left out,
+// so the weights start at count lo and the sum of the weights is total.
+func truncatedPoissonWeights(lambda float64, a, b int) (int, []float64, float64) {
+	if a < 0 {
+		a = 0
+	}
+	// Most likely count within the interval
+	m := int(math.Floor(lambda))
+	if m < a {
+		m = a
+	} else if m > b {
+		m = b
+	}
+	if lambda == 0 {
+		return m, []float64{1}, 1
+	}
+
+	const eps = 1e-17
+	total := 1.0
+	// Weights below the mode, in reverse order
+	var below []float64
+	w := 1.0
+	for k := m; k > a; k-- {
+		w *= float64(k) / lambda
+		if w < eps*total {
+			break
+		}
+		below = append(below, w)
+		total += w
+	}
+	// Weights above the mode
+	above := []float64{1}
+	w = 1.0
+	for k := m + 1; k <= b; k++ {
+		w *= lambda / float64(k)
+		if w < eps*total {
+			break
+		}
+		above = append(above, w)
+		total += w
+	}
+
+	weights := make([]float64, 0, len(below)+len(above))
+	for i := len(below) - 1; i >= 0; i-- {
+		weights = append(weights, below[i])
+	}
+	weights = append(weights, above...)
+	return m - len(below), weights, total
+}
+
+// ZeroInflatedPoisson draws a sample from a zero-inflated Poisson
+// distribution. With probability pi the count is a structural zero,
+// otherwise the count is drawn from a Poisson distribution with mean
+// lambda.
+func ZeroInflatedPoisson(pi, lambda float64) int {
+	if rand.Float64() < pi {
+		return 0
+	}
+	return poissonCount(lambda)
+}
+
+// ZeroInflatedPoissonPMF returns the probability of observing k events from
+// a zero-inflated Poisson distribution with zero-inflation probability pi
+// and Poisson mean lambda.
+func ZeroInflatedPoissonPMF(k int, pi, lambda float64) float64 {
+	p := (1 - pi) * PoissonPMF(k, lambda)
+	if k == 0 {
+		p += pi
+	}
+	return p
+}
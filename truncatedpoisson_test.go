@@ -0,0 +1,165 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestZeroTruncatedPoisson(t *testing.T) {
+	cases := []struct {
+		name   string
+		lambda float64
+	}{
+		{name: "exp=-8",
+			lambda: 1e-8,
+		},
+		{name: "exp=-1",
+			lambda: 1e-1,
+		},
+		{name: "exp=0",
+			lambda: 1e0,
+		},
+		{name: "exp=1",
+			lambda: 1e1,
+		},
+		{name: "exp=2",
+			lambda: 1e2,
+		},
+	}
+	iterations := 100000
+	errSize := 0.01
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var sum int
+			for i := 0; i < iterations; i++ {
+				k := ZeroTruncatedPoisson(tc.lambda)
+				if k < 1 {
+					t.Fatalf("expected at least one event, instead got %d", k)
+				}
+				sum += k
+			}
+			// Check mean
+			mean := float64(sum) / float64(iterations)
+			expected := tc.lambda / -math.Expm1(-tc.lambda)
+			err := expected * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+		})
+	}
+}
+
+func TestTruncatedPoisson(t *testing.T) {
+	cases := []struct {
+		name   string
+		lambda float64
+		a, b   int
+	}{
+		{name: "lambda=5,interval=[2,8]",
+			lambda: 5.0,
+			a:      2,
+			b:      8,
+		},
+		{name: "lambda=1e-6,interval=[3,5]",
+			lambda: 1e-6,
+			a:      3,
+			b:      5,
+		},
+		{name: "lambda=1,interval=[100,102]",
+			lambda: 1.0,
+			a:      100,
+			b:      102,
+		},
+		{name: "lambda=1000,interval=[0,950]",
+			lambda: 1000.0,
+			a:      0,
+			b:      950,
+		},
+		{name: "lambda=3,interval=[0,inf)",
+			lambda: 3.0,
+			a:      0,
+			b:      math.MaxInt32,
+		},
+	}
+	iterations := 100000
+	errSize := 0.01
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			cnt := make(map[int]int)
+			for i := 0; i < iterations; i++ {
+				k := TruncatedPoisson(tc.lambda, tc.a, tc.b)
+				if k < tc.a || k > tc.b {
+					t.Fatalf("count (%d) is outside [%d, %d]", k, tc.a, tc.b)
+				}
+				cnt[k]++
+			}
+			// Check frequency against PMF
+			var total float64
+			for k := tc.a; k <= tc.b && k <= tc.a+2000; k++ {
+				p := TruncatedPoissonPMF(k, tc.lambda, tc.a, tc.b)
+				total += p
+				freq := float64(cnt[k]) / float64(iterations)
+				if p+errSize < freq || p-errSize > freq {
+					t.Errorf("frequency of %d (%f) is greater than expected (%f) +/- (%f)", k, freq, p, errSize)
+				}
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("PMF sums to %f instead of 1", total)
+			}
+		})
+	}
+}
+
+func TestZeroInflatedPoisson(t *testing.T) {
+	cases := []struct {
+		name   string
+		pi     float64
+		lambda float64
+	}{
+		{name: "pi=0,lambda=2",
+			pi:     0.0,
+			lambda: 2.0,
+		},
+		{name: "pi=0.3,lambda=2",
+			pi:     0.3,
+			lambda: 2.0,
+		},
+		{name: "pi=0.9,lambda=50",
+			pi:     0.9,
+			lambda: 50.0,
+		},
+	}
+	iterations := 100000
+	errSize := 0.01
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var sum, zeros int
+			for i := 0; i < iterations; i++ {
+				k := ZeroInflatedPoisson(tc.pi, tc.lambda)
+				if k == 0 {
+					zeros++
+				}
+				sum += k
+			}
+			// Check mean and frequency of zeros
+			mean := float64(sum) / float64(iterations)
+			expected := (1 - tc.pi) * tc.lambda
+			err := expected * errSize * 5
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+			freq := float64(zeros) / float64(iterations)
+			expected = ZeroInflatedPoissonPMF(0, tc.pi, tc.lambda)
+			if expected+errSize <= freq || expected-errSize >= freq {
+				t.Errorf("frequency of zeros (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+			}
+		})
+	}
+}
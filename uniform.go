@@ -0,0 +1,20 @@
+package randomvariate
+
+import "math/rand"
+
+// Uniform draws a sample from a continuous uniform distribution over the
+// half-open interval [a, b).
+func Uniform(a, b float64) float64 {
+	return a + (b-a)*rand.Float64()
+}
+
+// openUniform draws a sample from a continuous uniform distribution over
+// the open interval (0, 1), which is safe to pass to quantile functions
+// that diverge at 0.
+func openUniform() float64 {
+	for {
+		if u := rand.Float64(); u > 0 {
+			return u
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/montanaflynn/stats"
+)
+
+func TestUniform(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b float64
+	}{
+		{name: "a=0,b=1",
+			a: 0.0,
+			b: 1.0,
+		},
+		{name: "a=-5,b=5",
+			a: -5.0,
+			b: 5.0,
+		},
+		{name: "a=10,b=100",
+			a: 10.0,
+			b: 100.0,
+		},
+	}
+	iterations := 100000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Simulate
+			var cnt []float64
+			for i := 0; i < iterations; i++ {
+				x := Uniform(tc.a, tc.b)
+				if x < tc.a || x >= tc.b {
+					t.Fatalf("value (%f) is outside [%f, %f)", x, tc.a, tc.b)
+				}
+				cnt = append(cnt, x)
+			}
+			// Check mean and variance
+			mean, _ := stats.Mean(cnt)
+			variance, _ := stats.Variance(cnt)
+			width := tc.b - tc.a
+			expected := (tc.a + tc.b) / 2
+			err := width * errSize
+			if expected+err <= mean || expected-err >= mean {
+				t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, err)
+			}
+			expected = width * width / 12
+			err = expected * errSize
+			if expected+err <= variance || expected-err >= variance {
+				t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// VonMises draws an angle from a von Mises distribution with mean
+// direction mu and concentration kappa >= 0. The result is wrapped to
+// [-pi, pi]. When kappa = 0 the angle is uniform on the circle, and as
+// kappa grows the distribution approaches a normal distribution with
+// variance 1/kappa.
+// Uses the rejection method of Best and Fisher (1979) with a wrapped
+// Cauchy envelope.
+func VonMises(mu, kappa float64) float64 {
+	if kappa == 0 {
+		return math.Remainder(mu+math.Pi*(2*rand.Float64()-1), 2*math.Pi)
+	}
+	// rho = (tau - sqrt(2 tau)) / (2 kappa), rearranged to avoid
+	// cancellation when kappa is small
+	s := math.Sqrt(1 + 4*kappa*kappa)
+	tau := 1 + s
+	rho := 2 * kappa * tau / ((s + 1) * (tau + math.Sqrt(2*tau)))
+	r := (1 + rho*rho) / (2 * rho)
+
+	var f float64
+	for {
+		z := math.Cos(math.Pi * rand.Float64())
+		f = (1 + r*z) / (r + z)
+		c := kappa * (r - f)
+		u := rand.Float64()
+		if c*(2-c) > u || math.Log(c/u)+1 >= c {
+			break
+		}
+	}
+	theta := math.Acos(f)
+	if rand.Float64() < 0.5 {
+		theta = -theta
+	}
+	return math.Remainder(mu+theta, 2*math.Pi)
+}
+
+// VonMisesPDF returns the probability density of a von Mises distribution
+// with mean direction mu and concentration kappa at the angle x.
+func VonMisesPDF(x, mu, kappa float64) float64 {
+	return math.Exp(kappa*math.Cos(x-mu)-LogBesselI(0, kappa)) / (2 * math.Pi)
+}
+
+// VonMisesFisher draws a unit vector from a von Mises-Fisher distribution
+// on the sphere in len(mu) dimensions, with mean direction mu and
+// concentration kappa >= 0, and stores it in dst. mu must be a unit vector
+// of at least two dimensions; otherwise nil is returned. If dst is too
+// short to hold the sample, a new slice is allocated.
+// Uses the method of Wood (1994): the component w along mu is drawn by
+// rejection from a beta envelope, the remaining components are a uniform
+// direction orthogonal to mu, and the result is reflected so that the
+// first axis maps to mu.
+func VonMisesFisher(dst, mu []float64, kappa float64) []float64 {
+	d := len(mu)
+	if d < 2 {
+		return nil
+	}
+	if len(dst) < d {
+		dst = make([]float64, d)
+	}
+	dst = dst[:d]
+	if kappa == 0 {
+		return UniformSphere(dst, d)
+	}
+
+	m := float64(d - 1)
+	b := m / (2*kappa + math.Sqrt(4*kappa*kappa+m*m))
+	x0 := (1 - b) / (1 + b)
+	c := kappa*x0 + m*math.Log(1-x0*x0)
+	var w float64
+	for {
+		z := betaVariate(m/2, m/2)
+		w = (1 - (1+b)*z) / (1 - (1-b)*z)
+		u := rand.Float64()
+		if kappa*w+m*math.Log(1-x0*w)-c >= math.Log(u) {
+			break
+		}
+	}
+
+	// Sample with mean direction along the first axis
+	UniformSphere(dst[1:], d-1)
+	scale := math.Sqrt(math.Max(0, 1-w*w))
+	for i := 1; i < d; i++ {
+		dst[i] *= scale
+	}
+	dst[0] = w
+
+	// Householder reflection that maps the first axis to mu, along the
+	// vector e_1 - mu
+	axis := func(i int) float64 {
+		if i == 0 {
+			return 1 - mu[0]
+		}
+		return -mu[i]
+	}
+	var norm, dot float64
+	for i := range mu {
+		norm += axis(i) * axis(i)
+		dot += axis(i) * dst[i]
+	}
+	if norm < 1e-30 {
+		return dst
+	}
+	for i := range mu {
+		dst[i] -= 2 * dot / norm * axis(i)
+	}
+	return dst
+}
@@ -0,0 +1,138 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestVonMises(t *testing.T) {
+	cases := []struct {
+		name      string
+		mu, kappa float64
+	}{
+		{name: "mu=0,kappa=0", mu: 0, kappa: 0},
+		{name: "mu=1,kappa=0.5", mu: 1, kappa: 0.5},
+		{name: "mu=-2,kappa=2", mu: -2, kappa: 2},
+		{name: "mu=3,kappa=50", mu: 3, kappa: 50},
+		{name: "mu=0,kappa=1e-8", mu: 0, kappa: 1e-8},
+	}
+	iterations := 50000
+	errSize := 0.01
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sumCos, sumSin float64
+			for i := 0; i < iterations; i++ {
+				x := VonMises(tc.mu, tc.kappa)
+				if x < -math.Pi || x > math.Pi {
+					t.Fatalf("expected angle in [-pi, pi], instead got %f", x)
+				}
+				sumCos += math.Cos(x - tc.mu)
+				sumSin += math.Sin(x - tc.mu)
+			}
+			// The mean resultant length is I_1(kappa) / I_0(kappa)
+			length := sumCos / float64(iterations)
+			expected := math.Exp(LogBesselI(1, tc.kappa) - LogBesselI(0, tc.kappa))
+			if expected+errSize < length || expected-errSize > length {
+				t.Errorf("mean resultant length (%f) is greater than expected (%f) +/- (%f)", length, expected, errSize)
+			}
+			if s := sumSin / float64(iterations); errSize < s || -errSize > s {
+				t.Errorf("mean sine (%f) is greater than expected (%f) +/- (%f)", s, 0.0, errSize)
+			}
+		})
+	}
+}
+
+func TestVonMisesPDF(t *testing.T) {
+	epsilon := 1e-9
+	for _, kappa := range []float64{0, 0.5, 4, 100} {
+		// Integrate the density over the circle with Simpson's rule
+		steps := 20000
+		h := 2 * math.Pi / float64(steps)
+		sum := VonMisesPDF(-math.Pi, 1, kappa) + VonMisesPDF(math.Pi, 1, kappa)
+		for i := 1; i < steps; i++ {
+			w := 2.0
+			if i%2 == 1 {
+				w = 4
+			}
+			sum += w * VonMisesPDF(-math.Pi+float64(i)*h, 1, kappa)
+		}
+		if v := sum * h / 3; math.Abs(v-1) > epsilon {
+			t.Errorf("kappa=%v: expected density to integrate to 1, instead got %f", kappa, v)
+		}
+	}
+}
+
+func TestVonMisesFisher(t *testing.T) {
+	cases := []struct {
+		name  string
+		mu    []float64
+		kappa float64
+		// mean resultant length A_d(kappa)
+		expected float64
+	}{
+		{name: "d=2,kappa=3",
+			mu:       []float64{0.6, -0.8},
+			kappa:    3,
+			expected: math.Exp(LogBesselI(1, 3) - LogBesselI(0, 3)),
+		},
+		{name: "d=3,kappa=0",
+			mu:       []float64{0, 0, 1},
+			kappa:    0,
+			expected: 0,
+		},
+		{name: "d=3,kappa=5",
+			mu:       []float64{1 / math.Sqrt(3), 1 / math.Sqrt(3), -1 / math.Sqrt(3)},
+			kappa:    5,
+			expected: 1/math.Tanh(5) - 1.0/5,
+		},
+		{name: "d=3,kappa=1,mu=axis",
+			mu:       []float64{1, 0, 0},
+			kappa:    1,
+			expected: 1/math.Tanh(1) - 1,
+		},
+		{name: "d=4,kappa=200",
+			mu:       []float64{0.5, 0.5, 0.5, -0.5},
+			kappa:    200,
+			expected: math.Exp(LogBesselI(2, 200) - LogBesselI(1, 200)),
+		},
+	}
+	iterations := 20000
+	errSize := 0.02
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := len(tc.mu)
+			sum := make([]float64, d)
+			buf := make([]float64, d)
+			for i := 0; i < iterations; i++ {
+				x := VonMisesFisher(buf, tc.mu, tc.kappa)
+				var norm float64
+				for j, v := range x {
+					norm += v * v
+					sum[j] += v
+				}
+				if math.Abs(norm-1) > 1e-9 {
+					t.Fatalf("expected a unit vector, instead got length %f", math.Sqrt(norm))
+				}
+			}
+			// The mean vector is A_d(kappa) mu
+			for j := range sum {
+				mean := sum[j] / float64(iterations)
+				expected := tc.expected * tc.mu[j]
+				if expected+errSize < mean || expected-errSize > mean {
+					t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expected, errSize)
+				}
+			}
+		})
+	}
+}
+
+func TestVonMisesFisherInvalid(t *testing.T) {
+	for _, mu := range [][]float64{nil, {1}} {
+		if x := VonMisesFisher(nil, mu, 2); x != nil {
+			t.Errorf("expected nil for mu=%v, instead got %v", mu, x)
+		}
+	}
+}
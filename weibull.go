@@ -0,0 +1,34 @@
+package randomvariate
+
+import "math"
+
+// Weibull draws a sample from a Weibull distribution with shape k and scale
+// lambda by inversion.
+func Weibull(k, lambda float64) float64 {
+	return WeibullQuantile(openUniform(), k, lambda)
+}
+
+// WeibullPDF returns the probability density of a Weibull distribution at
+// x.
+func WeibullPDF(x, k, lambda float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	z := x / lambda
+	return k / lambda * math.Pow(z, k-1) * math.Exp(-math.Pow(z, k))
+}
+
+// WeibullCDF returns the cumulative probability of a Weibull distribution
+// at x.
+func WeibullCDF(x, k, lambda float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return -math.Expm1(-math.Pow(x/lambda, k))
+}
+
+// WeibullQuantile returns the value x at which the cumulative probability
+// of a Weibull distribution is p.
+func WeibullQuantile(p, k, lambda float64) float64 {
+	return lambda * math.Pow(-math.Log1p(-p), 1/k)
+}
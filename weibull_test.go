@@ -0,0 +1,26 @@
+package randomvariate
+
+import "testing"
+
+func TestWeibull(t *testing.T) {
+	cases := []struct {
+		name      string
+		k, lambda float64
+	}{
+		{name: "k=0.5,lambda=1", k: 0.5, lambda: 1.0},
+		{name: "k=1,lambda=2", k: 1.0, lambda: 2.0},
+		{name: "k=3,lambda=10", k: 3.0, lambda: 10.0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draw := func() float64 { return Weibull(tc.k, tc.lambda) }
+			cdf := func(x float64) float64 { return WeibullCDF(x, tc.k, tc.lambda) }
+			pdf := func(x float64) float64 { return WeibullPDF(x, tc.k, tc.lambda) }
+			quantile := func(p float64) float64 { return WeibullQuantile(p, tc.k, tc.lambda) }
+			points := []float64{quantile(0.1), quantile(0.4), quantile(0.75), quantile(0.95)}
+			checkEmpiricalCDF(t, draw, cdf, points)
+			checkPDFIntegratesToCDF(t, pdf, cdf, points[0], points[len(points)-1])
+			checkQuantileInvertsCDF(t, quantile, cdf)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package randomvariate
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// WeightedSampleWithoutReplacement chooses k distinct indices with
+// probability proportional to weights, without replacement. Uses the
+// Efraimidis-Spirakis method, where each item is assigned the key
+// u^(1/w) for a uniform random u and the k items with the largest keys are
+// selected. Keys are handled as logarithms to avoid underflow with small
+// weights.
+// Returns the selected indices in the order they would have been drawn one
+// at a time. Items with non-positive weights are never selected, so fewer
+// than k indices are returned if fewer than k weights are positive.
+func WeightedSampleWithoutReplacement(k int, weights []float64) []int {
+	h := make(keyHeap, 0, k)
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		key := math.Log(rand.Float64()) / w
+		if len(h) < k {
+			heap.Push(&h, keyedIndex{key: key, index: i})
+		} else if k > 0 && key > h[0].key {
+			h[0] = keyedIndex{key: key, index: i}
+			heap.Fix(&h, 0)
+		}
+	}
+	return h.indices()
+}
+
+// WeightedReservoir selects k items with probability proportional to their
+// weights, without replacement, from a stream of items whose length is not
+// known in advance. Uses the A-ExpJ algorithm of Efraimidis and Spirakis,
+// which draws an exponential jump over the cumulative weight of the stream
+// so that random numbers are only generated for items that enter the
+// reservoir.
+type WeightedReservoir struct {
+	k     int
+	n     int
+	h     keyHeap
+	jumpW float64
+}
+
+// NewWeightedReservoir creates an empty reservoir holding at most k items.
+func NewWeightedReservoir(k int) *WeightedReservoir {
+	return &WeightedReservoir{k: k, h: make(keyHeap, 0, k)}
+}
+
+// Add offers the next item in the stream with weight w to the reservoir.
+// Items are identified by the order in which they were added, starting from
+// 0. Items with non-positive weights are never selected.
+func (r *WeightedReservoir) Add(w float64) {
+	i := r.n
+	r.n++
+	if w <= 0 || r.k <= 0 {
+		return
+	}
+
+	// Fill the reservoir
+	if len(r.h) < r.k {
+		heap.Push(&r.h, keyedIndex{key: math.Log(rand.Float64()) / w, index: i})
+		if len(r.h) == r.k {
+			r.jumpW = math.Log(rand.Float64()) / r.h[0].key
+		}
+		return
+	}
+
+	// Skip items until the cumulative weight exceeds the jump
+	r.jumpW -= w
+	if r.jumpW > 0 {
+		return
+	}
+	// Key of the new item is conditioned to exceed the current threshold
+	logT := w * r.h[0].key
+	u := Uniform(math.Exp(logT), 1)
+	r.h[0] = keyedIndex{key: math.Log(u) / w, index: i}
+	heap.Fix(&r.h, 0)
+	r.jumpW = math.Log(rand.Float64()) / r.h[0].key
+}
+
+// Indices returns the indices of the items currently in the reservoir, in
+// the order they would have been drawn one at a time.
+func (r *WeightedReservoir) Indices() []int {
+	h := make(keyHeap, len(r.h))
+	copy(h, r.h)
+	return h.indices()
+}
+
+// keyedIndex is an item index together with its random sampling key.
+type keyedIndex struct {
+	key   float64
+	index int
+}
+
+// keyHeap is a min-heap of keyed indices, so that the item with the
+// smallest key is at the root and is the first to be replaced.
+type keyHeap []keyedIndex
+
+func (h keyHeap) Len() int            { return len(h) }
+func (h keyHeap) Less(i, j int) bool  { return h[i].key < h[j].key }
+func (h keyHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *keyHeap) Push(x interface{}) { *h = append(*h, x.(keyedIndex)) }
+func (h *keyHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// indices returns the indices in the heap sorted by descending key. The
+// heap is reordered in the process.
+func (h keyHeap) indices() []int {
+	sort.Slice(h, func(a, b int) bool { return h[a].key > h[b].key })
+	result := make([]int, len(h))
+	for i, v := range h {
+		result[i] = v.index
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package randomvariate
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var weightedSampleCases = []struct {
+	name      string
+	k         int
+	weights   []float64
+	inclusion []float64
+}{
+	{name: "k=1,wlen=3",
+		k:         1,
+		weights:   []float64{1.0, 2.0, 7.0},
+		inclusion: []float64{0.1, 0.2, 0.7},
+	},
+	{name: "k=2,wlen=3",
+		k:         2,
+		weights:   []float64{1.0, 1.0, 2.0},
+		inclusion: []float64{7.0 / 12, 7.0 / 12, 10.0 / 12},
+	},
+	{name: "k=2,wlen=4,dist=zero",
+		k:         2,
+		weights:   []float64{0.0, 1.0, 1.0, 0.0},
+		inclusion: []float64{0.0, 1.0, 1.0, 0.0},
+	},
+	{name: "k=3,wlen=2",
+		k:         3,
+		weights:   []float64{1.0, 5.0},
+		inclusion: []float64{1.0, 1.0},
+	},
+	{name: "k=5,wlen=10,dist=uniform",
+		k:         5,
+		weights:   []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		inclusion: []float64{0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5},
+	},
+}
+
+func checkWeightedSample(t *testing.T, weights []float64, inclusion []float64, samples [][]int) {
+	var positive int
+	for _, w := range weights {
+		if w > 0 {
+			positive++
+		}
+	}
+	cnt := make([]int, len(weights))
+	first := make([]int, len(weights))
+	for _, s := range samples {
+		seen := make(map[int]bool)
+		for _, i := range s {
+			if seen[i] {
+				t.Fatalf("index (%d) was selected more than once", i)
+			}
+			seen[i] = true
+			cnt[i]++
+		}
+		if len(s) > 0 {
+			first[s[0]]++
+		}
+	}
+	errSize := 0.02
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	for i := range weights {
+		freq := float64(cnt[i]) / float64(len(samples))
+		expected := inclusion[i]
+		if expected+errSize < freq || expected-errSize > freq {
+			t.Errorf("inclusion frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+		}
+		// The first draw is proportional to the weights
+		freq = float64(first[i]) / float64(len(samples))
+		expected = weights[i] / total
+		if expected+errSize < freq || expected-errSize > freq {
+			t.Errorf("first draw frequency (%f) is greater than expected (%f) +/- (%f)", freq, expected, errSize)
+		}
+	}
+}
+
+func TestWeightedSampleWithoutReplacement(t *testing.T) {
+	iterations := 20000
+	rand.Seed(0)
+	for _, tc := range weightedSampleCases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := make([][]int, iterations)
+			for i := range samples {
+				samples[i] = WeightedSampleWithoutReplacement(tc.k, tc.weights)
+			}
+			checkWeightedSample(t, tc.weights, tc.inclusion, samples)
+		})
+	}
+}
+
+func TestWeightedReservoir(t *testing.T) {
+	iterations := 20000
+	rand.Seed(0)
+	for _, tc := range weightedSampleCases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := make([][]int, iterations)
+			for i := range samples {
+				r := NewWeightedReservoir(tc.k)
+				for _, w := range tc.weights {
+					r.Add(w)
+				}
+				samples[i] = r.Indices()
+			}
+			checkWeightedSample(t, tc.weights, tc.inclusion, samples)
+		})
+	}
+}
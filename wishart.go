@@ -0,0 +1,156 @@
+package randomvariate
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// Wishart is a Wishart distribution over n by n positive definite matrices
+// with a given number of degrees of freedom and scale matrix. The mean of
+// the distribution is df * scale. The Cholesky factor of the scale matrix
+// is computed once when the distribution is created.
+type Wishart struct {
+	df     float64
+	factor [][]float64
+}
+
+// NewWishart creates a Wishart distribution with df degrees of freedom and
+// the given scale matrix. Returns an error if scale is not square and
+// positive definite, or if df is not greater than n - 1.
+func NewWishart(df float64, scale [][]float64) (*Wishart, error) {
+	factor, err := bartlettSetup(df, scale)
+	if err != nil {
+		return nil, err
+	}
+	return &Wishart{df: df, factor: factor}, nil
+}
+
+// Dim returns the number of rows of the sampled matrices.
+func (w *Wishart) Dim() int {
+	return len(w.factor)
+}
+
+// Rand draws a random matrix from the distribution. Uses the Bartlett
+// decomposition W = (L A)(L A)^T, where L is the Cholesky factor of the
+// scale matrix and A is a lower-triangular matrix of chi and standard
+// normal variates.
+func (w *Wishart) Rand() Matrix {
+	a := bartlett(w.df, len(w.factor))
+	return outerProduct(multiplyLower(w.factor, a))
+}
+
+// InverseWishart is an inverse-Wishart distribution over n by n positive
+// definite matrices with a given number of degrees of freedom and scale
+// matrix. The mean of the distribution is scale / (df - n - 1) for
+// df > n + 1.
+type InverseWishart struct {
+	df     float64
+	factor [][]float64
+}
+
+// NewInverseWishart creates an inverse-Wishart distribution with df degrees
+// of freedom and the given scale matrix. Returns an error if scale is not
+// square and positive definite, or if df is not greater than n - 1.
+func NewInverseWishart(df float64, scale [][]float64) (*InverseWishart, error) {
+	factor, err := bartlettSetup(df, scale)
+	if err != nil {
+		return nil, err
+	}
+	return &InverseWishart{df: df, factor: factor}, nil
+}
+
+// Dim returns the number of rows of the sampled matrices.
+func (w *InverseWishart) Dim() int {
+	return len(w.factor)
+}
+
+// Rand draws a random matrix from the distribution. If X is drawn from a
+// Wishart distribution with scale matrix scale^-1 using the Bartlett
+// decomposition, then X^-1 = (L A^-T)(L A^-T)^T, where L is the Cholesky
+// factor of scale. This avoids inverting either matrix explicitly.
+func (w *InverseWishart) Rand() Matrix {
+	n := len(w.factor)
+	aInv := invertLower(bartlett(w.df, n))
+	// c = L A^-T, where A^-T is upper-triangular
+	c := NewMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			var sum float64
+			for k := 0; k <= i && k <= j; k++ {
+				sum += w.factor[i][k] * aInv[j][k]
+			}
+			c[i][j] = sum
+		}
+	}
+	return outerProduct(c)
+}
+
+// bartlettSetup validates the parameters of a Wishart or inverse-Wishart
+// distribution and returns the Cholesky factor of the scale matrix.
+func bartlettSetup(df float64, scale [][]float64) ([][]float64, error) {
+	n := len(scale)
+	for _, row := range scale {
+		if len(row) != n {
+			return nil, errors.New("scale matrix is not square")
+		}
+	}
+	if !(df > float64(n-1)) {
+		return nil, errors.New("degrees of freedom must be greater than the dimension minus one")
+	}
+	factor, ok := cholesky(scale)
+	if !ok {
+		return nil, errors.New("scale matrix is not positive definite")
+	}
+	return factor, nil
+}
+
+// bartlett draws the lower-triangular matrix A of the Bartlett
+// decomposition of an n by n Wishart matrix with df degrees of freedom and
+// identity scale. The i-th diagonal element is the square root of a
+// chi-square variate with df - i degrees of freedom and the elements below
+// the diagonal are standard normal.
+func bartlett(df float64, n int) [][]float64 {
+	a := NewMatrix(n)
+	for i := 0; i < n; i++ {
+		a[i][i] = math.Sqrt(ChiSquare(df - float64(i)))
+		for j := 0; j < i; j++ {
+			a[i][j] = rand.NormFloat64()
+		}
+	}
+	return a
+}
+
+// multiplyLower returns the product of the lower-triangular matrices l and
+// a.
+func multiplyLower(l, a [][]float64) Matrix {
+	n := len(l)
+	c := NewMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			var sum float64
+			for k := j; k <= i; k++ {
+				sum += l[i][k] * a[k][j]
+			}
+			c[i][j] = sum
+		}
+	}
+	return c
+}
+
+// outerProduct returns the symmetric matrix c c^T.
+func outerProduct(c [][]float64) Matrix {
+	n := len(c)
+	m := NewMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			var sum float64
+			for k := range c[i] {
+				sum += c[i][k] * c[j][k]
+			}
+			m[i][j] = sum
+			m[j][i] = sum
+		}
+	}
+	return m
+}
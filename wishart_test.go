@@ -0,0 +1,139 @@
+package randomvariate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestWishart(t *testing.T) {
+	cases := []struct {
+		name  string
+		df    float64
+		scale [][]float64
+	}{
+		{name: "dim=1,df=3", df: 3, scale: [][]float64{{2}}},
+		{name: "dim=2,df=5", df: 5, scale: [][]float64{{1, 0.5}, {0.5, 2}}},
+		{name: "dim=3,df=4.5", df: 4.5, scale: [][]float64{{1, 0.3, 0}, {0.3, 1, -0.2}, {0, -0.2, 0.5}}},
+	}
+	iterations := 20000
+	errSize := 0.05
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := NewWishart(tc.df, tc.scale)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			n := w.Dim()
+			sum := NewMatrix(n)
+			sumSq := NewMatrix(n)
+			for i := 0; i < iterations; i++ {
+				x := w.Rand()
+				if _, ok := cholesky(x); !ok {
+					t.Fatalf("sample is not positive definite")
+				}
+				for j := range x {
+					for k := range x[j] {
+						sum[j][k] += x[j][k]
+						sumSq[j][k] += x[j][k] * x[j][k]
+					}
+				}
+			}
+			for j := range sum {
+				for k := range sum[j] {
+					mean := sum[j][k] / float64(iterations)
+					variance := sumSq[j][k]/float64(iterations) - mean*mean
+					expMean := tc.df * tc.scale[j][k]
+					expVariance := tc.df * (tc.scale[j][k]*tc.scale[j][k] + tc.scale[j][j]*tc.scale[k][k])
+					if err := errSize * (1 + expVariance); expMean+err < mean || expMean-err > mean {
+						t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expMean, err)
+					}
+					if err := 2 * errSize * expVariance; expVariance+err < variance || expVariance-err > variance {
+						t.Errorf("variance (%f) is greater than expected (%f) +/- (%f)", variance, expVariance, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestInverseWishart(t *testing.T) {
+	cases := []struct {
+		name  string
+		df    float64
+		scale [][]float64
+	}{
+		{name: "dim=1,df=8", df: 8, scale: [][]float64{{3}}},
+		{name: "dim=2,df=10", df: 10, scale: [][]float64{{1, 0.5}, {0.5, 2}}},
+		{name: "dim=3,df=12", df: 12, scale: [][]float64{{4, 1, 0}, {1, 2, -0.5}, {0, -0.5, 1}}},
+	}
+	iterations := 20000
+	errSize := 0.03
+	rand.Seed(0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := NewInverseWishart(tc.df, tc.scale)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			n := w.Dim()
+			sum := NewMatrix(n)
+			for i := 0; i < iterations; i++ {
+				x := w.Rand()
+				if _, ok := cholesky(x); !ok {
+					t.Fatalf("sample is not positive definite")
+				}
+				for j := range x {
+					for k := range x[j] {
+						sum[j][k] += x[j][k]
+					}
+				}
+			}
+			for j := range sum {
+				for k := range sum[j] {
+					mean := sum[j][k] / float64(iterations)
+					// Tolerance relative to the scale of the entry
+					expMean := tc.scale[j][k] / (tc.df - float64(n) - 1)
+					err := errSize * math.Sqrt(tc.scale[j][j]*tc.scale[k][k]) / (tc.df - float64(n) - 1)
+					if expMean+err < mean || expMean-err > mean {
+						t.Errorf("mean (%f) is greater than expected (%f) +/- (%f)", mean, expMean, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewWishartError(t *testing.T) {
+	cases := []struct {
+		name  string
+		df    float64
+		scale [][]float64
+	}{
+		{name: "df=too_small", df: 1, scale: [][]float64{{1, 0}, {0, 1}}},
+		{name: "scale=not_square", df: 5, scale: [][]float64{{1, 0}, {0}}},
+		{name: "scale=not_positive_definite", df: 5, scale: [][]float64{{1, 1}, {1, 1}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewWishart(tc.df, tc.scale); err == nil {
+				t.Errorf("expected an error from NewWishart, instead got nil")
+			}
+			if _, err := NewInverseWishart(tc.df, tc.scale); err == nil {
+				t.Errorf("expected an error from NewInverseWishart, instead got nil")
+			}
+		})
+	}
+}
+
+func TestWishartMVNormal(t *testing.T) {
+	rand.Seed(0)
+	w, err := NewWishart(4, [][]float64{{1, 0.2}, {0.2, 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := NewMVNormal([]float64{0, 0}, w.Rand()); err != nil {
+		t.Errorf("expected a sampled matrix to be a valid covariance matrix: %v", err)
+	}
+}